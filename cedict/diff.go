@@ -0,0 +1,326 @@
+package cedict
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Key identifies an entry by its writing and its pronunciation in
+// CEDICT notation.
+type Key struct {
+	Traditional string
+	Simplified  string
+	Pinyin      string
+}
+
+// KeyOf returns the key of an entry.
+func KeyOf(e Entry) Key {
+	pins := make([]string, len(e.Pinyin))
+	for i, p := range e.Pinyin {
+		pins[i] = p.String()
+	}
+	return Key{
+		Traditional: e.Traditional,
+		Simplified:  e.Simplified,
+		Pinyin:      strings.Join(pins, " "),
+	}
+}
+
+func (k Key) String() string {
+	return k.Traditional + " " + k.Simplified + " [" + k.Pinyin + "]"
+}
+
+// ChangeKind tells whether an entry was added, removed or changed.
+type ChangeKind byte
+
+const (
+	// Added entries are only in the new version.
+	Added ChangeKind = iota
+	// Removed entries are only in the old version.
+	Removed
+	// Changed entries are in both versions, with different glosses.
+	Changed
+)
+
+// Change of an entry between two versions of a dictionary.
+type Change struct {
+	Kind ChangeKind
+	Key  Key
+	// Old and New are the versions of the entry. Old is nil for
+	// added entries, New is nil for removed entries.
+	Old *Entry
+	New *Entry
+	// AddedGlosses and RemovedGlosses are the glosses only found in
+	// the new or the old version.
+	AddedGlosses   []string
+	RemovedGlosses []string
+}
+
+// entryIndex maps keys to the first entry with that key.
+type entryIndex struct {
+	entries []*Entry
+	byKey   map[Key]*Entry
+}
+
+func newEntryIndex(lines []Line) *entryIndex {
+	idx := &entryIndex{
+		byKey: make(map[Key]*Entry),
+	}
+	for _, ln := range lines {
+		e, ok := ln.(Entry)
+		if !ok {
+			continue
+		}
+		key := KeyOf(e)
+		if _, exists := idx.byKey[key]; exists {
+			continue
+		}
+		idx.entries = append(idx.entries, &e)
+		idx.byKey[key] = &e
+	}
+	return idx
+}
+
+// Diff compares two versions of a dictionary by the keys of their
+// entries. Only the first entry with a given key is considered.
+// Added and changed entries are reported in the order of the new
+// version, followed by the removed entries in the order of the old
+// version.
+func Diff(old, new []Line) []Change {
+	oldIdx := newEntryIndex(old)
+	newIdx := newEntryIndex(new)
+	var changes []Change
+	for _, e := range newIdx.entries {
+		key := KeyOf(*e)
+		o, ok := oldIdx.byKey[key]
+		if !ok {
+			changes = append(changes, Change{
+				Kind:         Added,
+				Key:          key,
+				New:          e,
+				AddedGlosses: e.Meaning,
+			})
+			continue
+		}
+		if equalGlosses(o.Meaning, e.Meaning) {
+			continue
+		}
+		changes = append(changes, Change{
+			Kind:           Changed,
+			Key:            key,
+			Old:            o,
+			New:            e,
+			AddedGlosses:   subtractGlosses(e.Meaning, o.Meaning),
+			RemovedGlosses: subtractGlosses(o.Meaning, e.Meaning),
+		})
+	}
+	for _, e := range oldIdx.entries {
+		key := KeyOf(*e)
+		if _, ok := newIdx.byKey[key]; ok {
+			continue
+		}
+		changes = append(changes, Change{
+			Kind:           Removed,
+			Key:            key,
+			Old:            e,
+			RemovedGlosses: e.Meaning,
+		})
+	}
+	return changes
+}
+
+func equalGlosses(xs, ys []string) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+	for i := range xs {
+		if xs[i] != ys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// subtractGlosses returns the glosses of xs that are not in ys, every
+// gloss of ys removing at most one gloss of xs.
+func subtractGlosses(xs, ys []string) []string {
+	count := make(map[string]int)
+	for _, y := range ys {
+		count[y]++
+	}
+	var result []string
+	for _, x := range xs {
+		if count[x] > 0 {
+			count[x]--
+			continue
+		}
+		result = append(result, x)
+	}
+	return result
+}
+
+// Conflict is a local change that can't be carried onto the upstream
+// version cleanly in a three-way merge.
+type Conflict struct {
+	Key Key
+	// Local and Upstream are the versions of the entry, nil if it
+	// was removed.
+	Local    *Entry
+	Upstream *Entry
+	Reason   string
+}
+
+// Merge carries the local changes between the base and the local
+// version of a dictionary onto a new upstream version. Comments,
+// metadata and the order of the entries are taken from upstream;
+// locally added entries follow the entry they follow in the local
+// version, or precede all upstream entries if they come first. Entries changed on both sides get the local glosses added
+// to and removed from the upstream glosses. The annotations of
+// changed entries are parsed again according to the format.
+//
+// Changes that can't be applied cleanly are reported as conflicts:
+// Entries that were changed locally but removed upstream are kept,
+// entries that were removed locally but changed upstream are kept as
+// well, and if a gloss was changed on both sides, both the local and
+// the upstream version of the gloss are kept.
+func Merge(base, local, upstream []Line, format Format) ([]Line, []Conflict) {
+	vocabulary := vocabularies[format]
+	if vocabulary == nil {
+		vocabulary = vocabularies[CEDICT]
+	}
+	baseIdx := newEntryIndex(base)
+	localIdx := newEntryIndex(local)
+	upIdx := newEntryIndex(upstream)
+	localKeys := make([]Key, len(localIdx.entries))
+	localPos := make(map[Key]int)
+	for i, e := range localIdx.entries {
+		localKeys[i] = KeyOf(*e)
+		localPos[localKeys[i]] = i
+	}
+	var conflicts []Conflict
+	// merged versions of upstream entries, nil if removed
+	replaced := make(map[Key]*Entry)
+	// entries to insert after an upstream or inserted entry, the
+	// zero key for entries before any other
+	inserted := make(map[Key][]Entry)
+	isInserted := make(map[Key]bool)
+	insert := func(e *Entry) {
+		key := KeyOf(*e)
+		var after Key
+		for i := localPos[key] - 1; i >= 0; i-- {
+			k := localKeys[i]
+			if _, ok := upIdx.byKey[k]; ok || isInserted[k] {
+				after = k
+				break
+			}
+		}
+		inserted[after] = append(inserted[after], *e)
+		isInserted[key] = true
+	}
+	for _, ch := range Diff(base, local) {
+		up := upIdx.byKey[ch.Key]
+		switch ch.Kind {
+		case Added:
+			if up == nil {
+				insert(ch.New)
+				continue
+			}
+			merged := *up
+			merged.Meaning = append(append([]string{}, up.Meaning...),
+				subtractGlosses(ch.New.Meaning, up.Meaning)...)
+			replaced[ch.Key] = vocabulary.annotate(merged)
+		case Removed:
+			if up == nil {
+				continue
+			}
+			if !equalGlosses(up.Meaning, ch.Old.Meaning) {
+				conflicts = append(conflicts, Conflict{
+					Key:      ch.Key,
+					Upstream: up,
+					Reason:   "removed locally, changed upstream",
+				})
+				continue
+			}
+			replaced[ch.Key] = nil
+		case Changed:
+			if up == nil {
+				conflicts = append(conflicts, Conflict{
+					Key:    ch.Key,
+					Local:  ch.New,
+					Reason: "changed locally, removed upstream",
+				})
+				insert(ch.New)
+				continue
+			}
+			if equalGlosses(up.Meaning, baseIdx.byKey[ch.Key].Meaning) {
+				replaced[ch.Key] = ch.New
+				continue
+			}
+			meanings := subtractGlosses(up.Meaning, ch.RemovedGlosses)
+			// a gloss removed on both sides was changed upstream if
+			// upstream added glosses
+			upAdded := subtractGlosses(up.Meaning, baseIdx.byKey[ch.Key].Meaning)
+			for _, g := range subtractGlosses(ch.RemovedGlosses, up.Meaning) {
+				if len(upAdded) == 0 {
+					break
+				}
+				conflicts = append(conflicts, Conflict{
+					Key:      ch.Key,
+					Local:    ch.New,
+					Upstream: up,
+					Reason:   "gloss " + strconv.Quote(g) + " changed on both sides",
+				})
+			}
+			merged := *up
+			merged.Meaning = append(meanings,
+				subtractGlosses(ch.AddedGlosses, meanings)...)
+			replaced[ch.Key] = vocabulary.annotate(merged)
+		}
+	}
+	var result []Line
+	var emit func(after Key)
+	emit = func(after Key) {
+		for _, e := range inserted[after] {
+			result = append(result, e)
+			emit(KeyOf(e))
+		}
+	}
+	seen := make(map[Key]bool)
+	for _, ln := range upstream {
+		e, ok := ln.(Entry)
+		if !ok {
+			result = append(result, ln)
+			continue
+		}
+		if len(seen) == 0 {
+			// entries added before all others go ahead of the first
+			// upstream entry
+			emit(Key{})
+		}
+		key := KeyOf(e)
+		if seen[key] {
+			// duplicates are kept as they are
+			result = append(result, ln)
+			continue
+		}
+		seen[key] = true
+		if r, ok := replaced[key]; ok {
+			if r != nil {
+				result = append(result, *r)
+			}
+		} else {
+			result = append(result, e)
+		}
+		emit(key)
+	}
+	if len(seen) == 0 {
+		emit(Key{})
+	}
+	return result, conflicts
+}
+
+// annotate parses the annotations of an entry again.
+func (v *vocabulary) annotate(e Entry) *Entry {
+	e.Classifiers, e.References, e.Flags = v.parseAnnotations(e.Meaning)
+	return &e
+}
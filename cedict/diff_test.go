@@ -0,0 +1,140 @@
+package cedict
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func parseLines(t *testing.T, input string) []Line {
+	p, err := New(bytes.NewReader([]byte(input)),
+		WithCompression(Uncompressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines, err := p.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lines
+}
+
+func TestDiff(t *testing.T) {
+	old := parseLines(t, "# old\n"+
+		"人 人 [ren2] /person/people/\n"+
+		"書 书 [shu1] /book/\n"+
+		"做事 做事 [zuo4 shi4] /to work/\n")
+	new := parseLines(t, "# new\n"+
+		"人 人 [ren2] /person/people/\n"+
+		"書 书 [shu1] /book/letter/\n"+
+		"狗 狗 [gou3] /dog/\n")
+	var actual []string
+	for _, ch := range Diff(old, new) {
+		actual = append(actual, fmt.Sprintf("%d %s +%q -%q",
+			ch.Kind, ch.Key, ch.AddedGlosses, ch.RemovedGlosses))
+	}
+	expected := []string{
+		`2 書 书 [shu1] +["letter"] -[]`,
+		`0 狗 狗 [gou3] +["dog"] -[]`,
+		`1 做事 做事 [zuo4 shi4] +[] -["to work"]`,
+	}
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("wrong changes:\n%s", strings.Join(actual, "\n"))
+	}
+}
+
+func TestMerge(t *testing.T) {
+	base := parseLines(t, "#! version=1\n"+
+		"人 人 [ren2] /person/\n"+
+		"書 书 [shu1] /book/letter/\n"+
+		"王 王 [wang2] /king/\n"+
+		"做事 做事 [zuo4 shi4] /to work/\n"+
+		"馬 马 [ma3] /horse/\n")
+	local := parseLines(t, "#! version=1\n"+
+		"人 人 [ren2] /person/people/\n"+
+		"書 书 [shu1] /book/document/\n"+
+		"狗 狗 [gou3] /dog/\n"+
+		"做事 做事 [zuo4 shi4] /to work/to handle matters/\n"+
+		"馬 马 [ma3] /horse/CL:匹[pi3]/\n")
+	upstream := parseLines(t, "#! version=2\n"+
+		"人 人 [ren2] /person/\n"+
+		"書 书 [shu1] /book/\n"+
+		"王 王 [wang2] /king/monarch/\n"+
+		"馬 马 [ma3] /horse/surname Ma/\n")
+	merged, conflicts := Merge(base, local, upstream, CEDICT)
+	var actual []string
+	for _, ln := range merged {
+		switch sub := ln.(type) {
+		case Entry:
+			actual = append(actual, sub.String())
+		case Metadata:
+			actual = append(actual, sub.Key+"="+sub.Value)
+		}
+	}
+	expected := []string{
+		"version=2",
+		"人 人 [ren2] /person/people/",
+		"書 书 [shu1] /book/document/",
+		"狗 狗 [gou3] /dog/",
+		"做事 做事 [zuo4 shi4] /to work/to handle matters/",
+		"王 王 [wang2] /king/monarch/",
+		"馬 马 [ma3] /horse/surname Ma/CL:匹[pi3]/",
+	}
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("wrong merge:\n%s", strings.Join(actual, "\n"))
+	}
+	var reasons []string
+	for _, c := range conflicts {
+		reasons = append(reasons, c.Key.Traditional+": "+c.Reason)
+	}
+	expectedReasons := []string{
+		"做事: changed locally, removed upstream",
+		"王: removed locally, changed upstream",
+	}
+	if strings.Join(reasons, "\n") != strings.Join(expectedReasons, "\n") {
+		t.Errorf("wrong conflicts:\n%s", strings.Join(reasons, "\n"))
+	}
+	for _, ln := range merged {
+		if e, ok := ln.(Entry); ok && e.Traditional == "馬" {
+			if len(e.Classifiers) != 1 || e.Flags != Surname {
+				t.Errorf("wrong annotations: %+v", e)
+			}
+		}
+	}
+}
+
+func TestMergeAddedFirst(t *testing.T) {
+	base := parseLines(t, "#! version=1\n"+
+		"人 人 [ren2] /person/\n")
+	local := parseLines(t, "#! version=1\n"+
+		"狗 狗 [gou3] /dog/\n"+
+		"貓 猫 [mao1] /cat/\n"+
+		"人 人 [ren2] /person/\n")
+	upstream := parseLines(t, "#! version=2\n"+
+		"人 人 [ren2] /person/people/\n"+
+		"書 书 [shu1] /book/\n")
+	merged, conflicts := Merge(base, local, upstream, CEDICT)
+	if len(conflicts) != 0 {
+		t.Errorf("unexpected conflicts: %+v", conflicts)
+	}
+	var actual []string
+	for _, ln := range merged {
+		switch sub := ln.(type) {
+		case Entry:
+			actual = append(actual, sub.String())
+		case Metadata:
+			actual = append(actual, sub.Key+"="+sub.Value)
+		}
+	}
+	expected := []string{
+		"version=2",
+		"狗 狗 [gou3] /dog/",
+		"貓 猫 [mao1] /cat/",
+		"人 人 [ren2] /person/people/",
+		"書 书 [shu1] /book/",
+	}
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("wrong merge:\n%s", strings.Join(actual, "\n"))
+	}
+}
@@ -0,0 +1,56 @@
+package cedict
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseError describes a malformed line of a dictionary.
+type ParseError struct {
+	// Line number, starting at 1.
+	Line int
+	// Raw content of the line.
+	Raw string
+	// Reason why the line is malformed.
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s: %q", e.Line, e.Reason, e.Raw)
+}
+
+// Errors returns all malformed lines skipped by a lenient parser so
+// far.
+func (p *Parser) Errors() []*ParseError {
+	return p.errors
+}
+
+// Summary describes the malformed lines skipped by a lenient parser so
+// far, with the number of lines for every reason and their line
+// numbers. Returns an empty string if no lines were skipped.
+func (p *Parser) Summary() string {
+	if len(p.errors) == 0 {
+		return ""
+	}
+	lines := make(map[string][]int)
+	for _, err := range p.errors {
+		lines[err.Reason] = append(lines[err.Reason], err.Line)
+	}
+	reasons := make([]string, 0, len(lines))
+	for reason := range lines {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "skipped %d lines", len(p.errors))
+	for _, reason := range reasons {
+		nrs := make([]string, len(lines[reason]))
+		for i, nr := range lines[reason] {
+			nrs[i] = fmt.Sprint(nr)
+		}
+		fmt.Fprintf(&buf, "\n%s: %d (lines %s)",
+			reason, len(nrs), strings.Join(nrs, ", "))
+	}
+	return buf.String()
+}
@@ -0,0 +1,74 @@
+package cedict
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+const malformed = "#! version=1\n" +
+	"做事 做事 [zuo4 shi4] /to work/\n" +
+	"broken line\n" +
+	"#! =\n" +
+	"人 人 [ren2] /person/\n" +
+	"人 人 [ren2] no meanings\n"
+
+func TestStrict(t *testing.T) {
+	p, err := New(bytes.NewReader([]byte(malformed)),
+		WithCompression(Uncompressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		_, err = p.Next()
+		if err != nil {
+			break
+		}
+	}
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("wrong error: %v", err)
+	}
+	if perr.Line != 3 || perr.Raw != "broken line" ||
+		perr.Reason != "missing pinyin" {
+		t.Errorf("wrong parse error: %+v", perr)
+	}
+}
+
+func TestLenient(t *testing.T) {
+	p, err := New(bytes.NewReader([]byte(malformed)),
+		WithCompression(Uncompressed), Lenient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries int
+	for {
+		ln, err := p.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ln == nil {
+			break
+		}
+		if _, ok := ln.(Entry); ok {
+			entries++
+		}
+	}
+	if entries != 2 {
+		t.Errorf("wrong number of entries: %d", entries)
+	}
+	errs := p.Errors()
+	if len(errs) != 3 {
+		t.Fatalf("wrong number of errors: %d", len(errs))
+	}
+	if errs[1].Line != 4 || errs[1].Reason != "missing metadata key" {
+		t.Errorf("wrong parse error: %+v", errs[1])
+	}
+	expected := "skipped 3 lines\n" +
+		"missing metadata key: 1 (lines 4)\n" +
+		"missing pinyin: 1 (lines 3)\n" +
+		"no gloss: 1 (lines 6)"
+	if s := p.Summary(); s != expected {
+		t.Errorf("wrong summary:\n%s", s)
+	}
+}
@@ -1,6 +1,9 @@
 package cedict
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/hgoes/hanyu/pinyin"
 )
 
@@ -14,15 +17,85 @@ type Entry struct {
 	Simplified  string
 	Pinyin      []Pinyin
 	Meaning     []string
+	// Classifiers (measure words) of the word, given by "CL:"
+	// annotations of the meanings.
+	Classifiers []Word
+	// References to other words found in the meanings.
+	References []Reference
+	Flags      Flags
 }
 
 // Pinyin can be either an encoded pinyin, or for certain special
-// cases, a literal.
+// cases, a literal. Capitalized marks pinyin of proper nouns.
 type Pinyin struct {
-	Pinyin  pinyin.Pinyin
-	Literal string
+	Pinyin      pinyin.Pinyin
+	Literal     string
+	Capitalized bool
+}
+
+// String renders the pinyin in CEDICT notation, i.e. with a tone
+// number (5 for the neutral tone) and "u:" for "ü".
+func (p Pinyin) String() string {
+	if p.Literal != "" {
+		return p.Literal
+	}
+	sound, tone := p.Pinyin.Decode()
+	str := strings.ReplaceAll(sound.String(), "ü", "u:")
+	if p.Capitalized {
+		str = strings.ToUpper(str[:1]) + str[1:]
+	}
+	if tone == pinyin.Neutral {
+		return str + "5"
+	}
+	return str + strconv.Itoa(int(tone))
+}
+
+// Word identifies another word of the dictionary by its writing and
+// pronunciation, like in "個|个[ge4]".
+type Word struct {
+	Traditional string
+	Simplified  string
+	Pinyin      []Pinyin
 }
 
+// RefKind describes how a referenced word relates to an entry.
+type RefKind byte
+
+const (
+	// See refers to a related word ("see ...").
+	See RefKind = iota
+	// VariantOf refers to the word an entry is a variant of
+	// ("variant of ...").
+	VariantOf
+	// AbbreviationOf refers to the word an entry is an
+	// abbreviation for ("abbr. for ...").
+	AbbreviationOf
+	// AbbreviatedTo refers to the abbreviation of an entry ("abbr.
+	// to ...").
+	AbbreviatedTo
+	// UsedIn refers to a word an entry is used in ("used in ...").
+	UsedIn
+)
+
+// Reference from an entry to another word.
+type Reference struct {
+	Kind RefKind
+	Word
+}
+
+// Flags mark special kinds of entries.
+type Flags byte
+
+const (
+	// Variant marks entries that are a variant of another word.
+	Variant Flags = 1 << iota
+	// Surname marks entries that can be used as a surname.
+	Surname
+	// Abbreviation marks entries that are an abbreviation of
+	// another word.
+	Abbreviation
+)
+
 // Metadata can be attached to the dictionary for processing purposes.
 type Metadata struct {
 	Key   string
@@ -0,0 +1,221 @@
+package cedict
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+// vocabulary contains the phrases that introduce annotations in the
+// meanings of a [Format].
+type vocabulary struct {
+	classifier string
+	surname    string
+	// variant may be preceded by qualifiers like "old"
+	variant    string
+	references []reference
+}
+
+type reference struct {
+	text string
+	kind RefKind
+}
+
+var vocabularies = map[Format]*vocabulary{
+	CEDICT: {
+		classifier: "CL:",
+		surname:    "surname ",
+		variant:    "variant of ",
+		references: []reference{
+			{"see ", See},
+			{"abbr. for ", AbbreviationOf},
+			{"abbr. of ", AbbreviationOf},
+			{"abbr. to ", AbbreviatedTo},
+			{"used in ", UsedIn},
+		},
+	},
+	CFDICT: {
+		classifier: "CL:",
+		surname:    "nom de famille",
+		variant:    "variante de ",
+		references: []reference{
+			{"voir ", See},
+			{"abr. de ", AbbreviationOf},
+			{"abr. pour ", AbbreviationOf},
+			{"utilisé dans ", UsedIn},
+		},
+	},
+	HanDeDict: {
+		classifier: "Zähl.:",
+		surname:    "Familienname",
+		variant:    "Variante von ",
+		references: []reference{
+			{"siehe ", See},
+			{"Abk. für ", AbbreviationOf},
+			{"Abk. von ", AbbreviationOf},
+			{"verwendet in ", UsedIn},
+		},
+	},
+}
+
+// parseAnnotations extracts classifiers, references and flags from
+// the glosses of an entry.
+func (v *vocabulary) parseAnnotations(
+	meanings []string,
+) ([]Word, []Reference, Flags) {
+	var classifiers []Word
+	var refs []Reference
+	var flags Flags
+	for _, m := range meanings {
+		if idx := strings.Index(m, v.classifier); idx != -1 {
+			cl := m[idx+len(v.classifier):]
+			if end := strings.IndexByte(cl, ')'); end != -1 {
+				cl = cl[:end]
+			}
+			classifiers = append(classifiers, parseWords(cl)...)
+			continue
+		}
+		if strings.HasPrefix(m, v.surname) {
+			flags |= Surname
+			continue
+		}
+		kind, rest, ok := v.referenceKind(m)
+		if !ok {
+			continue
+		}
+		words := parseWords(rest)
+		if len(words) == 0 {
+			continue
+		}
+		switch kind {
+		case VariantOf:
+			flags |= Variant
+		case AbbreviationOf:
+			flags |= Abbreviation
+		}
+		for _, w := range words {
+			refs = append(refs, Reference{
+				Kind: kind,
+				Word: w,
+			})
+		}
+	}
+	return classifiers, refs, flags
+}
+
+// referenceKind determines the kind of references a gloss contains,
+// either at its start or in parentheses, and returns the part of the
+// gloss containing them.
+func (v *vocabulary) referenceKind(m string) (RefKind, string, bool) {
+	for _, prefix := range v.references {
+		if strings.HasPrefix(m, prefix.text) {
+			return prefix.kind, m[len(prefix.text):], true
+		}
+		// the reference can also be put in parentheses
+		if idx := strings.Index(m, "("+prefix.text); idx != -1 {
+			return prefix.kind, m[idx+1+len(prefix.text):], true
+		}
+	}
+	if idx := v.variantIndex(m); idx != -1 {
+		return VariantOf, m[idx:], true
+	}
+	return 0, "", false
+}
+
+// variantIndex returns the end of "variant of" in a gloss, which may
+// be preceded by a few qualifiers like "old" or "erhua" and may be
+// put in parentheses after the gloss. Returns -1 if the gloss doesn't
+// name a variant.
+func (v *vocabulary) variantIndex(m string) int {
+	idx := strings.Index(m, v.variant)
+	if idx == -1 || (idx > 0 && m[idx-1] != ' ' && m[idx-1] != '(') {
+		return -1
+	}
+	qualifiers := m[:idx]
+	if open := strings.LastIndexByte(qualifiers, '('); open != -1 {
+		qualifiers = qualifiers[open+1:]
+	}
+	words := strings.Fields(qualifiers)
+	if len(words) > 3 {
+		return -1
+	}
+	for _, w := range words {
+		for _, r := range strings.Trim(w, "()") {
+			if !unicode.Is(unicode.Latin, r) {
+				return -1
+			}
+		}
+	}
+	return idx + len(v.variant)
+}
+
+// parseWords finds all chinese words in a text, written as
+// "trad|simp[pinyin]", "word[pinyin]" or without the pinyin.
+func parseWords(text string) []Word {
+	var result []Word
+	for len(text) > 0 {
+		start := strings.IndexFunc(text, func(r rune) bool {
+			return !isWordSeparator(r)
+		})
+		if start == -1 {
+			break
+		}
+		text = text[start:]
+		end := strings.IndexFunc(text, isWordSeparator)
+		if end == -1 {
+			end = len(text)
+		}
+		word := text[:end]
+		text = text[end:]
+		var pins []Pinyin
+		if strings.HasPrefix(text, "[") {
+			if close := strings.IndexByte(text, ']'); close != -1 {
+				pins = parsePinyins(text[1:close])
+				text = text[close+1:]
+			}
+		}
+		if strings.IndexFunc(word, func(r rune) bool {
+			return unicode.Is(unicode.Han, r)
+		}) == -1 {
+			continue
+		}
+		trad, simp, ok := strings.Cut(word, "|")
+		if !ok {
+			simp = trad
+		}
+		result = append(result, Word{
+			Traditional: trad,
+			Simplified:  simp,
+			Pinyin:      pins,
+		})
+	}
+	return result
+}
+
+func isWordSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(",;:()[]", r)
+}
+
+// parsePinyins parses space separated pinyin syllables. Syllables
+// that are not valid pinyin are kept as literals.
+func parsePinyins(raw string) []Pinyin {
+	var result []Pinyin
+	for _, field := range strings.Fields(raw) {
+		r := []rune(field)
+		pins, rest := pinyin.ParseMany(r)
+		if len(rest) != 0 || len(pins) == 0 {
+			result = append(result, Pinyin{
+				Literal: field,
+			})
+			continue
+		}
+		for i, p := range pins {
+			result = append(result, Pinyin{
+				Pinyin:      p,
+				Capitalized: i == 0 && unicode.IsUpper(r[0]),
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package cedict
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func renderWord(w Word) string {
+	var pins []string
+	for _, p := range w.Pinyin {
+		if p.Literal != "" {
+			pins = append(pins, p.Literal)
+		} else {
+			pins = append(pins, fmt.Sprint(p.Pinyin.Decode()))
+		}
+	}
+	return w.Traditional + "|" + w.Simplified + "[" + strings.Join(pins, " ") + "]"
+}
+
+func TestAnnotations(t *testing.T) {
+	tests := []struct {
+		Input       []string
+		Classifiers []string
+		References  []string
+		Flags       Flags
+	}{
+		{
+			Input:       []string{"person", "CL:個|个[ge4],位[wei4]"},
+			Classifiers: []string{"個|个[ge 4]", "位|位[wei 4]"},
+		},
+		{
+			Input:       []string{"road (CL:條|条[tiao2])"},
+			Classifiers: []string{"條|条[tiao 2]"},
+		},
+		{
+			Input:      []string{"variant of 著|着[zhe5]"},
+			References: []string{"1:著|着[zhe 0]"},
+			Flags:      Variant,
+		},
+		{
+			Input:      []string{"old variant of 碗[wan3]"},
+			References: []string{"1:碗|碗[wan 3]"},
+			Flags:      Variant,
+		},
+		{
+			Input:      []string{"see 可不是[ke3 bu5 shi4]"},
+			References: []string{"0:可不是|可不是[ke 3 bu 0 shi 4]"},
+		},
+		{
+			Input:      []string{"abbr. for 北愛爾蘭|北爱尔兰[Bei3 Ai4 er3 lan2], Northern Ireland"},
+			References: []string{"2:北愛爾蘭|北爱尔兰[bei 3 ai 4 er 3 lan 2]"},
+			Flags:      Abbreviation,
+		},
+		{
+			Input:      []string{"Peking University (abbr. for 北京大學|北京大学)"},
+			References: []string{"2:北京大學|北京大学[]"},
+			Flags:      Abbreviation,
+		},
+		{
+			Input:      []string{"Shaanxi", "abbr. to 陝|陕[Shan3]"},
+			References: []string{"3:陝|陕[shan 3]"},
+		},
+		{
+			Input:      []string{"used in 琵琶[pi2pa5]"},
+			References: []string{"4:琵琶|琵琶[pi 2 pa 0]"},
+		},
+		{
+			Input: []string{"surname Wang", "king"},
+			Flags: Surname,
+		},
+		{
+			Input:      []string{"to stay (old variant of 住[zhu4])"},
+			References: []string{"1:住|住[zhu 4]"},
+			Flags:      Variant,
+		},
+		{
+			Input:      []string{"variant of 仙, immortal"},
+			References: []string{"1:仙|仙[]"},
+			Flags:      Variant,
+		},
+		{
+			Input: []string{"a variant of the game"},
+		},
+		{
+			Input: []string{"cohomology (invariant of a topological space in math.)"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(strings.Join(test.Input, "/"), func(t *testing.T) {
+			cls, refs, flags := vocabularies[CEDICT].parseAnnotations(test.Input)
+			var actCls, actRefs []string
+			for _, cl := range cls {
+				actCls = append(actCls, renderWord(cl))
+			}
+			for _, ref := range refs {
+				actRefs = append(actRefs,
+					fmt.Sprintf("%d:%s", ref.Kind, renderWord(ref.Word)))
+			}
+			if strings.Join(actCls, ",") != strings.Join(test.Classifiers, ",") {
+				t.Errorf("wrong classifiers: %q", actCls)
+			}
+			if strings.Join(actRefs, ",") != strings.Join(test.References, ",") {
+				t.Errorf("wrong references: %q", actRefs)
+			}
+			if flags != test.Flags {
+				t.Errorf("wrong flags: %d", flags)
+			}
+		})
+	}
+}
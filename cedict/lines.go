@@ -0,0 +1,185 @@
+package cedict
+
+import (
+	"context"
+	"sync"
+)
+
+// Result of decoding a line, as sent by [Parser.Lines].
+type Result struct {
+	Line Line
+	// Nr is the line number, starting at 1. It's 0 if the source
+	// couldn't be read or the context was cancelled.
+	Nr  int
+	Err error
+}
+
+// batchSize is the number of lines decoded by a worker at once.
+const batchSize = 256
+
+// batch of raw lines, decoded by a worker.
+type batch struct {
+	raw     [][]byte
+	nrs     []int
+	err     error
+	results []Result
+	done    chan struct{}
+}
+
+// Lines decodes the remaining lines of the dictionary in the
+// background and sends them in order on the returned channel. The
+// lines are decoded by the number of goroutines set with [Workers].
+//
+// Like with [Parser.Next], malformed lines are sent as a
+// [*ParseError], unless the parser is lenient. After an error, the
+// channel is closed. The context must be cancelled if the caller
+// stops reading before the channel is closed. Then the context's
+// error is sent as the last result, possibly replacing lines that
+// haven't been received yet, and the channel is closed. The parser
+// must not be used until the channel is closed; afterwards,
+// [Parser.Errors] reports the skipped lines.
+func (p *Parser) Lines(ctx context.Context) <-chan Result {
+	ctx, cancel := context.WithCancel(ctx)
+	workers := p.workers
+	if workers < 1 {
+		workers = 1
+	}
+	out := make(chan Result, batchSize)
+	// batches in line order, for the results to be sent in order
+	queue := make(chan *batch, 2*workers)
+	jobs := make(chan *batch, 2*workers)
+	var wg sync.WaitGroup
+	wg.Add(1 + workers)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		defer close(queue)
+		for {
+			b := p.readBatch()
+			if b == nil {
+				return
+			}
+			select {
+			case queue <- b:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- b:
+			case <-ctx.Done():
+				return
+			}
+			if b.err != nil {
+				return
+			}
+		}
+	}()
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for b := range jobs {
+				p.decodeBatch(b)
+			}
+		}()
+	}
+	go func() {
+		// whether all results were sent
+		finished := false
+		defer func() {
+			if !finished {
+				sendLast(out, Result{Err: ctx.Err()})
+			}
+			cancel()
+			wg.Wait()
+			close(out)
+		}()
+		for b := range queue {
+			select {
+			case <-b.done:
+			case <-ctx.Done():
+				return
+			}
+			for _, res := range b.results {
+				if perr, ok := res.Err.(*ParseError); ok && p.lenient {
+					p.errors = append(p.errors, perr)
+					continue
+				}
+				select {
+				case out <- res:
+				case <-ctx.Done():
+					return
+				}
+				if res.Err != nil {
+					finished = true
+					return
+				}
+			}
+		}
+		// the queue is also closed early on cancellation
+		finished = ctx.Err() == nil
+	}()
+	return out
+}
+
+// sendLast sends a result on a channel without blocking, discarding
+// results not received yet if the channel is full. There must be no
+// other sender.
+func sendLast(out chan Result, res Result) {
+	for {
+		select {
+		case out <- res:
+			return
+		default:
+		}
+		select {
+		case <-out:
+		default:
+		}
+	}
+}
+
+// readBatch reads the next non-empty lines. Returns nil at the end of
+// the dictionary.
+func (p *Parser) readBatch() *batch {
+	b := &batch{
+		done: make(chan struct{}),
+	}
+	for len(b.raw) < batchSize {
+		if !p.reader.Scan() {
+			b.err = p.reader.Err()
+			if b.err == nil && len(b.raw) == 0 {
+				return nil
+			}
+			break
+		}
+		p.lineNr++
+		raw := p.reader.Bytes()
+		if len(raw) == 0 {
+			continue
+		}
+		b.raw = append(b.raw, append([]byte(nil), raw...))
+		b.nrs = append(b.nrs, p.lineNr)
+	}
+	return b
+}
+
+func (p *Parser) decodeBatch(b *batch) {
+	b.results = make([]Result, 0, len(b.raw)+1)
+	for i, raw := range b.raw {
+		ln, err := p.parse(b.nrs[i], raw)
+		res := Result{
+			Line: ln,
+			Nr:   b.nrs[i],
+		}
+		if err != nil {
+			res.Err = err
+		}
+		b.results = append(b.results, res)
+	}
+	if b.err != nil {
+		b.results = append(b.results, Result{
+			Err: b.err,
+		})
+	}
+	close(b.done)
+}
@@ -0,0 +1,111 @@
+package cedict
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	raw, err := os.ReadFile("../cedict_1_0_ts_utf-8_mdbg.txt.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := New(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := p.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, workers := range []int{1, 4} {
+		t.Run(fmt.Sprint(workers), func(t *testing.T) {
+			p, err := New(bytes.NewReader(raw), Workers(workers))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var actual []Line
+			lastNr := 0
+			for res := range p.Lines(context.Background()) {
+				if res.Err != nil {
+					t.Fatal(res.Err)
+				}
+				if res.Nr <= lastNr {
+					t.Fatalf("line %d after line %d", res.Nr, lastNr)
+				}
+				lastNr = res.Nr
+				actual = append(actual, res.Line)
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("lines differ from Next")
+			}
+		})
+	}
+}
+
+func TestLinesErrors(t *testing.T) {
+	p, err := New(bytes.NewReader([]byte(malformed)),
+		WithCompression(Uncompressed), Workers(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nrs []int
+	var perr *ParseError
+	for res := range p.Lines(context.Background()) {
+		nrs = append(nrs, res.Nr)
+		if res.Err != nil && !errors.As(res.Err, &perr) {
+			t.Fatalf("wrong error: %v", res.Err)
+		}
+	}
+	if !reflect.DeepEqual(nrs, []int{1, 2, 3}) || perr == nil ||
+		perr.Line != 3 || perr.Reason != "missing pinyin" {
+		t.Errorf("wrong results %v with error %v", nrs, perr)
+	}
+	p, err = New(bytes.NewReader([]byte(malformed)),
+		WithCompression(Uncompressed), Workers(2), Lenient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nrs = nil
+	for res := range p.Lines(context.Background()) {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		nrs = append(nrs, res.Nr)
+	}
+	if !reflect.DeepEqual(nrs, []int{1, 2, 5}) || len(p.Errors()) != 3 {
+		t.Errorf("wrong results %v with errors %v", nrs, p.Errors())
+	}
+}
+
+func TestLinesCancel(t *testing.T) {
+	raw, err := os.ReadFile("../cedict_1_0_ts_utf-8_mdbg.txt.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := New(bytes.NewReader(raw), Workers(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	results := p.Lines(ctx)
+	<-results
+	cancel()
+	count := 0
+	var last Result
+	for res := range results {
+		count++
+		last = res
+	}
+	if count > 2*batchSize*(2*4+1) {
+		t.Errorf("%d lines after cancellation", count)
+	}
+	if last.Err != context.Canceled {
+		t.Errorf("wrong last result: %v", last.Err)
+	}
+}
@@ -0,0 +1,115 @@
+package cedict
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// Compression of a dictionary source.
+type Compression byte
+
+const (
+	// Gzip sources are decompressed. This is the default.
+	Gzip Compression = iota
+	// Uncompressed sources are read as plain UTF-8.
+	Uncompressed
+	// AutoDetect decompresses sources starting with the gzip magic
+	// bytes and reads all others as plain UTF-8.
+	AutoDetect
+)
+
+// Format is a dialect of the CEDICT format. The dialects only differ
+// in the language of the meanings, which determines how annotations
+// like classifiers and references are recognized (see [Entry]).
+type Format byte
+
+const (
+	// CEDICT is the original chinese-english dictionary.
+	CEDICT Format = iota
+	// CFDICT is the chinese-french dictionary.
+	CFDICT
+	// HanDeDict is the chinese-german dictionary.
+	HanDeDict
+)
+
+// Option configures a [Parser].
+type Option func(*options)
+
+type options struct {
+	compression Compression
+	stripBOM    bool
+	format      Format
+	lenient     bool
+	workers     int
+}
+
+// WithCompression sets the compression of the source.
+func WithCompression(c Compression) Option {
+	return func(o *options) {
+		o.compression = c
+	}
+}
+
+// StripBOM removes a UTF-8 byte order mark from the start of the
+// (decompressed) source.
+func StripBOM() Option {
+	return func(o *options) {
+		o.stripBOM = true
+	}
+}
+
+// WithFormat sets the dialect of the source.
+func WithFormat(f Format) Option {
+	return func(o *options) {
+		o.format = f
+	}
+}
+
+// Lenient makes the parser skip malformed lines instead of failing.
+// The skipped lines are available from [Parser.Errors].
+func Lenient() Option {
+	return func(o *options) {
+		o.lenient = true
+	}
+}
+
+// Workers sets the number of goroutines decoding lines in
+// [Parser.Lines]. By default, a single goroutine is used.
+func Workers(n int) Option {
+	return func(o *options) {
+		o.workers = n
+	}
+}
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+var bom = []byte{0xef, 0xbb, 0xbf}
+
+// open decompresses the source and strips the byte order mark as
+// configured by the options.
+func (o *options) open(src io.Reader) (io.Reader, error) {
+	compressed := o.compression == Gzip
+	if o.compression == AutoDetect {
+		buf := bufio.NewReader(src)
+		magic, _ := buf.Peek(len(gzipMagic))
+		compressed = bytes.Equal(magic, gzipMagic)
+		src = buf
+	}
+	if compressed {
+		rd, err := gzip.NewReader(src)
+		if err != nil {
+			return nil, err
+		}
+		src = rd
+	}
+	if o.stripBOM {
+		buf := bufio.NewReader(src)
+		if start, _ := buf.Peek(len(bom)); bytes.Equal(start, bom) {
+			buf.Discard(len(bom))
+		}
+		src = buf
+	}
+	return src, nil
+}
@@ -0,0 +1,130 @@
+package cedict
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+)
+
+func gzipped(input string) []byte {
+	var buf bytes.Buffer
+	wr := gzip.NewWriter(&buf)
+	wr.Write([]byte(input))
+	wr.Close()
+	return buf.Bytes()
+}
+
+func TestOptions(t *testing.T) {
+	const input = "做事 做事 [zuo4 shi4] /to work/\n"
+	tests := []struct {
+		Name    string
+		Input   []byte
+		Options []Option
+	}{
+		{
+			Name:  "gzip",
+			Input: gzipped(input),
+		},
+		{
+			Name:    "plain",
+			Input:   []byte(input),
+			Options: []Option{WithCompression(Uncompressed)},
+		},
+		{
+			Name:    "detect gzip",
+			Input:   gzipped(input),
+			Options: []Option{WithCompression(AutoDetect)},
+		},
+		{
+			Name:    "detect plain",
+			Input:   []byte(input),
+			Options: []Option{WithCompression(AutoDetect)},
+		},
+		{
+			Name:    "bom",
+			Input:   append([]byte{0xef, 0xbb, 0xbf}, input...),
+			Options: []Option{WithCompression(AutoDetect), StripBOM()},
+		},
+		{
+			Name:    "gzip bom",
+			Input:   gzipped("\ufeff" + input),
+			Options: []Option{StripBOM()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			p, err := New(bytes.NewReader(test.Input), test.Options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ln, err := p.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			e, ok := ln.(Entry)
+			if !ok {
+				t.Fatalf("wrong line type: %T", ln)
+			}
+			if e.Traditional != "做事" {
+				t.Errorf("wrong word: %q", e.Traditional)
+			}
+		})
+	}
+}
+
+func TestFormats(t *testing.T) {
+	tests := []struct {
+		Format     Format
+		Input      string
+		Classifier string
+		Reference  string
+		Flags      Flags
+	}{
+		{
+			Format: CFDICT,
+			Input: "人 人 [ren2] /personne/CL:個|个[ge4]/\n" +
+				"箇 个 [ge4] /variante de 個|个[ge4]/\n",
+			Classifier: "个",
+			Reference:  "个",
+			Flags:      Variant,
+		},
+		{
+			Format: HanDeDict,
+			Input: "人 人 [ren2] /Mensch (S)/Zähl.: 個|个[ge4]/\n" +
+				"王 王 [Wang2] /Familienname Wang/siehe 王國|王国[wang2 guo2]/\n",
+			Classifier: "个",
+			Reference:  "王国",
+			Flags:      Surname,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			p, err := New(bytes.NewReader([]byte(test.Input)),
+				WithCompression(Uncompressed), WithFormat(test.Format))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ln, err := p.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := ln.(Entry)
+			if len(e.Classifiers) != 1 ||
+				e.Classifiers[0].Simplified != test.Classifier {
+				t.Errorf("wrong classifiers: %v", e.Classifiers)
+			}
+			ln, err = p.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			e = ln.(Entry)
+			if len(e.References) != 1 ||
+				e.References[0].Simplified != test.Reference {
+				t.Errorf("wrong references: %v", e.References)
+			}
+			if e.Flags != test.Flags {
+				t.Errorf("wrong flags: %d", e.Flags)
+			}
+		})
+	}
+}
@@ -3,38 +3,57 @@ package cedict
 
 import (
 	"bufio"
-	"bytes"
-	"compress/gzip"
 	"fmt"
 	"io"
-	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/hgoes/hanyu/pinyin"
 )
 
 // Parser reads a CEDICT dictionary file entry by entry
 type Parser struct {
-	reader *bufio.Scanner
-	lineNr int
+	reader     *bufio.Scanner
+	lineNr     int
+	vocabulary *vocabulary
+	lenient    bool
+	workers    int
+	errors     []*ParseError
 }
 
-// New creates a new [Parser]. The source must be a gzip'ed
-// dictionary, containing a CEDICT entry in each line.
-func New(src io.Reader) (*Parser, error) {
-	rd, err := gzip.NewReader(src)
+// New creates a new [Parser]. By default, the source must be a
+// gzip'ed dictionary, containing a CEDICT entry in each line. Other
+// sources can be read by passing options.
+func New(src io.Reader, opts ...Option) (*Parser, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	vocabulary, ok := vocabularies[o.format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %d", o.format)
+	}
+	rd, err := o.open(src)
 	if err != nil {
 		return nil, err
 	}
 	return &Parser{
-		reader: bufio.NewScanner(rd),
+		reader:     bufio.NewScanner(rd),
+		vocabulary: vocabulary,
+		lenient:    o.lenient,
+		workers:    o.workers,
 	}, nil
 }
 
-var regEntry = regexp.MustCompile(`^([^ ]+) ([^ ]+) \[([^]]*)\] /+((:?[^/]+/?)+)/$`)
-
-var regMD = regexp.MustCompile(`^ *([^ =]+) *= *(.*)$`)
-
-// Next yields the next [Line] from the dictionary.
+// Next yields the next [Line] from the dictionary. Malformed lines
+// are reported as [*ParseError], unless the parser is lenient (see
+// [Lenient]), in which case they are skipped.
+//
+// Entries are read as written, so that [Writer] reproduces them:
+// empty glosses are kept (a leading "//" yields a leading empty
+// gloss), and syllables without tone number, like the latin letter
+// in "A片 A片 [A pian4]", are kept as [Pinyin.Literal]. Entries with
+// empty glosses are still reported by the cedict-lint command.
 func (p *Parser) Next() (Line, error) {
 	for {
 		if !p.reader.Scan() {
@@ -44,53 +63,173 @@ func (p *Parser) Next() (Line, error) {
 			return nil, nil
 		}
 		p.lineNr++
-		ln := p.reader.Bytes()
-		if len(ln) == 0 {
+		raw := p.reader.Bytes()
+		if len(raw) == 0 {
 			continue
 		}
-		if ln[0] == '#' {
-			if len(ln) > 1 && ln[1] == '!' {
-				match := regMD.FindSubmatch(ln[2:])
-				if len(match) == 0 {
-					return nil, fmt.Errorf(
-						"line %d: invalid metadata: %q",
-						p.lineNr, ln[2:])
-				}
-				return Metadata{
-					Key:   string(match[1]),
-					Value: string(match[2]),
-				}, nil
-			}
-			return Comment(string(ln[1:])), nil
+		ln, err := p.parse(p.lineNr, raw)
+		if err == nil {
+			return ln, nil
+		}
+		if !p.lenient {
+			return nil, err
+		}
+		p.errors = append(p.errors, err)
+	}
+}
+
+// ReadAll reads all remaining lines of the dictionary.
+func (p *Parser) ReadAll() ([]Line, error) {
+	var lines []Line
+	for {
+		ln, err := p.Next()
+		if err != nil {
+			return nil, err
+		}
+		if ln == nil {
+			return lines, nil
 		}
-		match := regEntry.FindSubmatch(ln)
-		if len(match) == 0 {
-			return nil, fmt.Errorf(
-				"line %d: invalid entry: %q", p.lineNr, ln)
+		lines = append(lines, ln)
+	}
+}
+
+// Line returns the line number of the line last returned by
+// [Parser.Next], starting at 1.
+func (p *Parser) Line() int {
+	return p.lineNr
+}
+
+// parse parses a non-empty line with the given line number.
+func (p *Parser) parse(nr int, raw []byte) (Line, *ParseError) {
+	ln, reason := p.parseLine(raw)
+	if reason == "" {
+		return ln, nil
+	}
+	return nil, &ParseError{
+		Line:   nr,
+		Raw:    string(raw),
+		Reason: reason,
+	}
+}
+
+// parseLine parses a non-empty line. Returns the reason why the line
+// is malformed, if it is.
+func (p *Parser) parseLine(ln []byte) (Line, string) {
+	if ln[0] == '#' {
+		if len(ln) > 1 && ln[1] == '!' {
+			return parseMetadata(string(ln[2:]))
+		}
+		return Comment(string(ln[1:])), ""
+	}
+	return p.parseEntry(string(ln))
+}
+
+// parseMetadata parses the "key = value" part of a metadata line.
+func parseMetadata(ln string) (Line, string) {
+	ln = strings.TrimLeft(ln, " ")
+	key := ln
+	if i := strings.IndexAny(ln, " ="); i >= 0 {
+		key = ln[:i]
+	}
+	if key == "" {
+		return nil, "missing metadata key"
+	}
+	rest := strings.TrimLeft(ln[len(key):], " ")
+	if !strings.HasPrefix(rest, "=") {
+		return nil, "missing ="
+	}
+	return Metadata{
+		Key:   key,
+		Value: strings.TrimLeft(rest[1:], " "),
+	}, ""
+}
+
+// parseEntry parses an entry of the form
+// "traditional simplified [pinyin] /gloss/.../". The fields of the
+// entry share the memory of the line.
+func (p *Parser) parseEntry(ln string) (Line, string) {
+	trad, rest, ok := strings.Cut(ln, " ")
+	if trad == "" {
+		return nil, "missing traditional"
+	}
+	if !ok {
+		return nil, "missing simplified"
+	}
+	simp, rest, ok := strings.Cut(rest, " ")
+	if simp == "" {
+		return nil, "missing simplified"
+	}
+	if !ok || !strings.HasPrefix(rest, "[") {
+		return nil, "missing pinyin"
+	}
+	rawPinyins, rest, ok := strings.Cut(rest[1:], "]")
+	if !ok {
+		return nil, "missing closing ]"
+	}
+	if !strings.HasPrefix(rest, " /") {
+		return nil, "no gloss"
+	}
+	rest = rest[2:]
+	if !strings.HasSuffix(rest, "/") {
+		return nil, "missing closing /"
+	}
+	meanings := strings.Split(rest[:len(rest)-1], "/")
+	classifiers, refs, flags := p.vocabulary.parseAnnotations(meanings)
+	return Entry{
+		Traditional: trad,
+		Simplified:  simp,
+		Pinyin:      parseSyllables(rawPinyins),
+		Meaning:     meanings,
+		Classifiers: classifiers,
+		References:  refs,
+		Flags:       flags,
+	}, ""
+}
+
+// parseSyllables parses the space separated syllables of an entry.
+func parseSyllables(raw string) []Pinyin {
+	runes := []rune(raw)
+	count := 0
+	inField := false
+	for _, r := range runes {
+		isSpace := unicode.IsSpace(r)
+		if !isSpace && !inField {
+			count++
 		}
-		trad := string(match[1])
-		simp := string(match[2])
-		rawPinyins := bytes.Fields(match[3])
-		pinyins := make([]Pinyin, len(rawPinyins))
-		for i, raw := range rawPinyins {
-			r := bytes.Runes(raw)
-			ok, pin, rest := pinyin.Parse(r)
-			if !ok || len(rest) != 0 {
-				pinyins[i].Literal = string(r)
-			} else {
-				pinyins[i].Pinyin = pin
+		inField = !isSpace
+	}
+	pinyins := make([]Pinyin, 0, count)
+	start := -1
+	for i, r := range runes {
+		switch {
+		case !unicode.IsSpace(r):
+			if start < 0 {
+				start = i
 			}
+		case start >= 0:
+			pinyins = append(pinyins, parsePinyin(runes[start:i]))
+			start = -1
 		}
-		rawMeanings := bytes.Split(match[4], []byte{'/'})
-		meanings := make([]string, len(rawMeanings))
-		for i, m := range rawMeanings {
-			meanings[i] = string(m)
+	}
+	if start >= 0 {
+		pinyins = append(pinyins, parsePinyin(runes[start:]))
+	}
+	return pinyins
+}
+
+// parsePinyin parses a syllable with a tone number. Syllables without
+// a tone number, like latin letters, are kept as literals.
+func parsePinyin(r []rune) Pinyin {
+	if last := r[len(r)-1]; last >= '1' && last <= '5' {
+		ok, pin, rest := pinyin.Parse(r)
+		if ok && len(rest) == 0 {
+			return Pinyin{
+				Pinyin:      pin,
+				Capitalized: unicode.IsUpper(r[0]),
+			}
 		}
-		return Entry{
-			Traditional: trad,
-			Simplified:  simp,
-			Pinyin:      pinyins,
-			Meaning:     meanings,
-		}, nil
+	}
+	return Pinyin{
+		Literal: string(r),
 	}
 }
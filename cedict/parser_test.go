@@ -3,7 +3,14 @@ package cedict
 import (
 	"bytes"
 	"compress/gzip"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/hgoes/hanyu/pinyin"
 )
 
 func Test(t *testing.T) {
@@ -36,6 +43,9 @@ func Test(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if p.Line() != 2 {
+		t.Errorf("wrong line number: %d", p.Line())
+	}
 	switch sub := ln2.(type) {
 	case Entry:
 		if len(sub.Meaning) != 3 {
@@ -49,3 +59,218 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+// regBaselineEntry is the entry regexp of the original parser, which
+// collapsed leading slashes and rejected empty glosses.
+var regBaselineEntry = regexp.MustCompile(`^([^ ]+) ([^ ]+) \[([^]]*)\] /+((:?[^/]+/?)+)/$`)
+
+// TestVerbatimEntries checks the lines read differently since entries
+// are read as written.
+func TestVerbatimEntries(t *testing.T) {
+	tests := []struct {
+		Line     string
+		Baseline bool
+		Meaning  []string
+	}{
+		{"做事 做事 [zuo4 shi4] /to work/", true, []string{"to work"}},
+		{"做事 做事 [zuo4 shi4] //to work/", true, []string{"", "to work"}},
+		{"做事 做事 [zuo4 shi4] /to work//", true, []string{"to work", ""}},
+		{"做事 做事 [zuo4 shi4] /to work//to do/", false,
+			[]string{"to work", "", "to do"}},
+		{"做事 做事 [zuo4 shi4] //", false, []string{""}},
+	}
+	p := &Parser{
+		vocabulary: vocabularies[CEDICT],
+	}
+	for _, test := range tests {
+		t.Run(test.Line, func(t *testing.T) {
+			if ok := regBaselineEntry.MatchString(test.Line); ok != test.Baseline {
+				t.Errorf("baseline accepts: %v", ok)
+			}
+			ln, reason := p.parseLine([]byte(test.Line))
+			if reason != "" {
+				t.Fatalf("rejected: %s", reason)
+			}
+			if m := ln.(Entry).Meaning; !reflect.DeepEqual(m, test.Meaning) {
+				t.Errorf("wrong meanings: %q", m)
+			}
+		})
+	}
+	// the baseline parsed the latin letter as syllable a
+	ln, _ := p.parseLine([]byte("A片 A片 [A pian4] /adult movie/"))
+	pins := ln.(Entry).Pinyin
+	if pins[0].Literal != "A" {
+		t.Errorf("letter not kept as literal: %#v", pins[0])
+	}
+	if pins[1].Literal != "" || pins[1].String() != "pian4" {
+		t.Errorf("wrong syllable: %#v", pins[1])
+	}
+}
+
+var regMD = regexp.MustCompile(`^ *([^ =]+) *= *(.*)$`)
+
+// regexpParseLine is the original, regexp based implementation of
+// [Parser.parseLine], extended by the annotations of the glosses.
+// Returns false if the line is malformed.
+func (p *Parser) regexpParseLine(ln []byte) (Line, bool) {
+	if ln[0] == '#' {
+		if len(ln) > 1 && ln[1] == '!' {
+			match := regMD.FindSubmatch(ln[2:])
+			if len(match) == 0 {
+				return nil, false
+			}
+			return Metadata{
+				Key:   string(match[1]),
+				Value: string(match[2]),
+			}, true
+		}
+		return Comment(string(ln[1:])), true
+	}
+	match := regBaselineEntry.FindSubmatch(ln)
+	if len(match) == 0 {
+		return nil, false
+	}
+	trad := string(match[1])
+	simp := string(match[2])
+	rawPinyins := bytes.Fields(match[3])
+	pinyins := make([]Pinyin, len(rawPinyins))
+	for i, raw := range rawPinyins {
+		r := bytes.Runes(raw)
+		ok, pin, rest := pinyin.Parse(r)
+		if !ok || len(rest) != 0 {
+			pinyins[i].Literal = string(r)
+		} else {
+			pinyins[i].Pinyin = pin
+		}
+	}
+	rawMeanings := bytes.Split(match[4], []byte{'/'})
+	meanings := make([]string, len(rawMeanings))
+	for i, m := range rawMeanings {
+		meanings[i] = string(m)
+	}
+	classifiers, refs, flags := p.vocabulary.parseAnnotations(meanings)
+	return Entry{
+		Traditional: trad,
+		Simplified:  simp,
+		Pinyin:      pinyins,
+		Meaning:     meanings,
+		Classifiers: classifiers,
+		References:  refs,
+		Flags:       flags,
+	}, true
+}
+
+// FuzzParseLine compares [Parser.parseLine] with the original parser.
+// The intended differences (see [Parser.Next] and
+// TestVerbatimEntries) are not reported: entries with empty glosses,
+// syllables without tone number kept as literals and the
+// capitalization of syllables.
+func FuzzParseLine(f *testing.F) {
+	for _, ln := range strings.Split(malformed, "\n") {
+		f.Add(ln)
+	}
+	f.Add("# comment")
+	f.Add("#!  key =  value ")
+	f.Add("人 人 [ren2] //CL:個|个[ge4],位[wei4]/")
+	f.Add("A片 A片 [A  pian4] /adult movie/")
+	f.Add("做事 做事 [zuo4 shi4 /to work/")
+	f.Add("做事 做事 [zuo4\u3000shi4] /to work/ /")
+	f.Add("北京 北京 [Bei3 jing1] /Beijing/")
+	p := &Parser{
+		vocabulary: vocabularies[CEDICT],
+	}
+	f.Fuzz(func(t *testing.T, ln string) {
+		// the scanner splits the source at newlines
+		if ln == "" || strings.ContainsRune(ln, '\n') {
+			return
+		}
+		expected, ok := p.regexpParseLine([]byte(ln))
+		actual, reason := p.parseLine([]byte(ln))
+		if e, isEntry := actual.(Entry); isEntry {
+			for _, m := range e.Meaning {
+				if m == "" {
+					return
+				}
+			}
+		}
+		if ok != (reason == "") {
+			t.Fatalf("original parser accepts %q: %v, reason: %q",
+				ln, ok, reason)
+		}
+		if e, isEntry := expected.(Entry); isEntry {
+			act := actual.(Entry)
+			for i := range e.Pinyin {
+				if i >= len(act.Pinyin) {
+					break
+				}
+				lit := act.Pinyin[i].Literal
+				if lit != "" && !strings.ContainsAny(lit[len(lit)-1:], "12345") {
+					e.Pinyin[i] = act.Pinyin[i]
+				} else {
+					e.Pinyin[i].Capitalized = act.Pinyin[i].Capitalized
+				}
+			}
+			expected = e
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("different results for %q:\n%#v\n%#v",
+				ln, actual, expected)
+		}
+	})
+}
+
+// dictionaryLines returns the non-empty lines of the bundled
+// dictionary.
+func dictionaryLines(b *testing.B) [][]byte {
+	raw, err := os.ReadFile("../cedict_1_0_ts_utf-8_mdbg.txt.gz")
+	if err != nil {
+		b.Fatal(err)
+	}
+	rd, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		b.Fatal(err)
+	}
+	content, err := io.ReadAll(rd)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var lines [][]byte
+	for _, ln := range bytes.Split(content, []byte("\r\n")) {
+		if len(ln) != 0 {
+			lines = append(lines, ln)
+		}
+	}
+	return lines
+}
+
+func BenchmarkParseLine(b *testing.B) {
+	lines := dictionaryLines(b)
+	p := &Parser{
+		vocabulary: vocabularies[CEDICT],
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, ln := range lines {
+			if _, reason := p.parseLine(ln); reason != "" {
+				b.Fatal(reason)
+			}
+		}
+	}
+}
+
+func BenchmarkRegexpParseLine(b *testing.B) {
+	lines := dictionaryLines(b)
+	p := &Parser{
+		vocabulary: vocabularies[CEDICT],
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, ln := range lines {
+			if _, ok := p.regexpParseLine(ln); !ok {
+				b.Fatalf("malformed line %q", ln)
+			}
+		}
+	}
+}
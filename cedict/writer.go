@@ -0,0 +1,106 @@
+package cedict
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Writer writes a CEDICT dictionary file line by line.
+type Writer struct {
+	// LineEnding separates the lines, "\n" if empty. The CEDICT
+	// distribution uses "\r\n".
+	LineEnding string
+	// NoFinalLineEnding omits the line ending after the last line,
+	// like the CEDICT distribution does.
+	NoFinalLineEnding bool
+	writer            *bufio.Writer
+	gzip              *gzip.Writer
+	lines             int
+}
+
+// NewWriter creates a new [Writer] for an uncompressed dictionary.
+func NewWriter(dst io.Writer) *Writer {
+	return &Writer{
+		writer: bufio.NewWriter(dst),
+	}
+}
+
+// NewGzipWriter creates a new [Writer] for a gzip'ed dictionary, as
+// read by [New].
+func NewGzipWriter(dst io.Writer) *Writer {
+	gz := gzip.NewWriter(dst)
+	return &Writer{
+		writer: bufio.NewWriter(gz),
+		gzip:   gz,
+	}
+}
+
+func (w *Writer) lineEnding() string {
+	if w.LineEnding == "" {
+		return "\n"
+	}
+	return w.LineEnding
+}
+
+// Write writes a [Line] to the dictionary.
+func (w *Writer) Write(ln Line) error {
+	if w.lines > 0 {
+		if _, err := w.writer.WriteString(w.lineEnding()); err != nil {
+			return err
+		}
+	}
+	w.lines++
+	var err error
+	switch sub := ln.(type) {
+	case Entry:
+		_, err = w.writer.WriteString(sub.String())
+	case Metadata:
+		_, err = fmt.Fprintf(w.writer, "#! %s=%s", sub.Key, sub.Value)
+	case Comment:
+		_, err = fmt.Fprintf(w.writer, "#%s", sub)
+	default:
+		err = fmt.Errorf("unknown line type %T", ln)
+	}
+	return err
+}
+
+// String renders the entry as a line of a CEDICT dictionary.
+func (e Entry) String() string {
+	var buf strings.Builder
+	buf.WriteString(e.Traditional)
+	buf.WriteByte(' ')
+	buf.WriteString(e.Simplified)
+	buf.WriteString(" [")
+	for i, p := range e.Pinyin {
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(p.String())
+	}
+	buf.WriteString("] /")
+	for _, m := range e.Meaning {
+		buf.WriteString(m)
+		buf.WriteByte('/')
+	}
+	return buf.String()
+}
+
+// Close writes the final line ending and flushes the dictionary. It
+// doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if w.lines > 0 && !w.NoFinalLineEnding {
+		if _, err := w.writer.WriteString(w.lineEnding()); err != nil {
+			return err
+		}
+	}
+	if err := w.writer.Flush(); err != nil {
+		return err
+	}
+	if w.gzip != nil {
+		return w.gzip.Close()
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package cedict
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	wr := NewWriter(&buf)
+	lines := []Line{
+		Comment(" corrections"),
+		Metadata{Key: "version", Value: "1"},
+		Entry{
+			Traditional: "女",
+			Simplified:  "女",
+			Pinyin: []Pinyin{
+				{Pinyin: pinyin.New(pinyin.NÜ, pinyin.Low)},
+			},
+			Meaning: []string{"female", "woman"},
+		},
+		Entry{
+			Traditional: "卡拉OK",
+			Simplified:  "卡拉OK",
+			Pinyin: []Pinyin{
+				{Pinyin: pinyin.New(pinyin.KA, pinyin.Low)},
+				{Pinyin: pinyin.New(pinyin.LA, pinyin.Neutral)},
+				{Literal: "O"},
+				{Literal: "K"},
+			},
+			Meaning: []string{"karaoke"},
+		},
+		Entry{
+			Traditional: "呂",
+			Simplified:  "吕",
+			Pinyin: []Pinyin{
+				{Pinyin: pinyin.New(pinyin.LÜ, pinyin.Low), Capitalized: true},
+			},
+			Meaning: []string{"surname Lü"},
+		},
+	}
+	for _, ln := range lines {
+		if err := wr.Write(ln); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wr.Close(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "# corrections\n" +
+		"#! version=1\n" +
+		"女 女 [nu:3] /female/woman/\n" +
+		"卡拉OK 卡拉OK [ka3 la5 O K] /karaoke/\n" +
+		"呂 吕 [Lu:3] /surname Lü/\n"
+	if buf.String() != expected {
+		t.Errorf("wrong output:\n%s", buf.String())
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	raw, err := os.ReadFile("../cedict_1_0_ts_utf-8_mdbg.txt.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig, err := io.ReadAll(rd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := New(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	wr := NewWriter(&buf)
+	wr.LineEnding = "\r\n"
+	wr.NoFinalLineEnding = true
+	for {
+		ln, err := p.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ln == nil {
+			break
+		}
+		if err := wr.Write(ln); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wr.Close(); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.Bytes()
+	if bytes.Equal(out, orig) {
+		return
+	}
+	for i := range out {
+		if i >= len(orig) || out[i] != orig[i] {
+			start := bytes.LastIndexByte(orig[:i], '\n') + 1
+			t.Fatalf("output differs at byte %d: %q", i, orig[start:i+1])
+		}
+	}
+	t.Fatalf("output too short: %d < %d", len(out), len(orig))
+}
@@ -0,0 +1,131 @@
+// Compares two versions of a CEDICT dictionary, or carries local
+// corrections onto a new upstream release with a three-way merge.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+var formats = map[string]cedict.Format{
+	"cedict":    cedict.CEDICT,
+	"cfdict":    cedict.CFDICT,
+	"handedict": cedict.HanDeDict,
+}
+
+func main() {
+	merge := flag.Bool("merge", false,
+		"merge the changes from base to local onto upstream")
+	format := flag.String("format", "cedict",
+		"dialect of the dictionaries (cedict, cfdict or handedict)")
+	output := flag.String("o", "", "write the merged dictionary to a file instead of stdout")
+	compress := flag.Bool("gzip", false, "compress the merged dictionary")
+	crlf := flag.Bool("crlf", false,
+		"end the lines of the merged dictionary with CRLF and omit the final line ending, like the CEDICT distribution")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(),
+			"Usage: %s [flags] old new\n"+
+				"       %s -merge [flags] base local upstream\n\n",
+			os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	f, ok := formats[*format]
+	if !ok || (*merge && flag.NArg() != 3) || (!*merge && flag.NArg() != 2) {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dicts := make([][]cedict.Line, flag.NArg())
+	for i, path := range flag.Args() {
+		lines, err := read(path, f)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			os.Exit(2)
+		}
+		dicts[i] = lines
+	}
+	if !*merge {
+		changes := cedict.Diff(dicts[0], dicts[1])
+		printChanges(os.Stdout, changes)
+		if len(changes) != 0 {
+			os.Exit(1)
+		}
+		return
+	}
+	merged, conflicts := cedict.Merge(dicts[0], dicts[1], dicts[2], f)
+	out := os.Stdout
+	if *output != "" {
+		h, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		defer h.Close()
+		out = h
+	}
+	var wr *cedict.Writer
+	if *compress {
+		wr = cedict.NewGzipWriter(out)
+	} else {
+		wr = cedict.NewWriter(out)
+	}
+	if *crlf {
+		wr.LineEnding = "\r\n"
+		wr.NoFinalLineEnding = true
+	}
+	for _, ln := range merged {
+		if err := wr.Write(ln); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+	if err := wr.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	for _, c := range conflicts {
+		fmt.Fprintf(os.Stderr, "conflict: %s: %s\n", c.Key, c.Reason)
+	}
+	if len(conflicts) != 0 {
+		os.Exit(1)
+	}
+}
+
+func read(path string, f cedict.Format) ([]cedict.Line, error) {
+	h, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+	p, err := cedict.New(h,
+		cedict.WithCompression(cedict.AutoDetect),
+		cedict.StripBOM(),
+		cedict.WithFormat(f))
+	if err != nil {
+		return nil, err
+	}
+	return p.ReadAll()
+}
+
+func printChanges(w io.Writer, changes []cedict.Change) {
+	for _, ch := range changes {
+		switch ch.Kind {
+		case cedict.Added:
+			fmt.Fprintln(w, "+", ch.New)
+		case cedict.Removed:
+			fmt.Fprintln(w, "-", ch.Old)
+		case cedict.Changed:
+			fmt.Fprintln(w, "~", ch.Key)
+			for _, g := range ch.RemovedGlosses {
+				fmt.Fprintln(w, "  -", g)
+			}
+			for _, g := range ch.AddedGlosses {
+				fmt.Fprintln(w, "  +", g)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+func parse(t *testing.T, input string) []cedict.Line {
+	p, err := cedict.New(bytes.NewReader([]byte(input)),
+		cedict.WithCompression(cedict.Uncompressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines, err := p.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lines
+}
+
+func TestPrintChanges(t *testing.T) {
+	old := parse(t, "人 人 [ren2] /person/\n"+
+		"書 书 [shu1] /book/\n")
+	new := parse(t, "人 人 [ren2] /person/people/\n"+
+		"狗 狗 [gou3] /dog/\n")
+	var buf bytes.Buffer
+	printChanges(&buf, cedict.Diff(old, new))
+	expected := "~ 人 人 [ren2]\n" +
+		"  + people\n" +
+		"+ 狗 狗 [gou3] /dog/\n" +
+		"- 書 书 [shu1] /book/\n"
+	if buf.String() != expected {
+		t.Errorf("wrong output:\n%s", buf.String())
+	}
+}
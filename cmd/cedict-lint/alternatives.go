@@ -0,0 +1,29 @@
+package main
+
+// alternatives are simplified forms of traditional characters that are
+// accepted besides the one in simplified.Replacements. Mostly, the
+// simplified form depends on the reading or meaning of the character,
+// like 餘 (余 and 馀) or 麼 (么 and 麽). The list is maintained by hand,
+// so that errors in the linted dictionary can't extend it.
+var alternatives = map[rune]string{
+	'乹': "乾",
+	'亁': "乾",
+	'參': "叁",
+	'噁': "恶",
+	'妳': "你",
+	'殽': "淆",
+	'耑': "专",
+	'蘋': "苹",
+	'襬': "䙓",
+	'諮': "咨",
+	'讌': "宴",
+	'讎': "仇",
+	'鏇': "旋",
+	'鑪': "炉",
+	'閤': "合",
+	'靦': "䩄",
+	'餔': "哺",
+	'餘': "余",
+	'麴': "曲",
+	'麼': "么",
+}
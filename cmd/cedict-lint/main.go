@@ -0,0 +1,197 @@
+// Checks a CEDICT dictionary for problems and prints them as JSON
+// lines. Exits with status 1 if any problems are found.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/hgoes/hanyu/cedict"
+	"github.com/hgoes/hanyu/simplified"
+)
+
+// Problem found in a line of the dictionary.
+type Problem struct {
+	Line    int    `json:"line"`
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+var formats = map[string]cedict.Format{
+	"cedict":    cedict.CEDICT,
+	"cfdict":    cedict.CFDICT,
+	"handedict": cedict.HanDeDict,
+}
+
+func main() {
+	format := flag.String("format", "cedict",
+		"dialect of the dictionary (cedict, cfdict or handedict)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(),
+			"Usage: %s [flags] [dictionary]\n\n"+
+				"Reads from stdin if no dictionary is given.\n\n",
+			os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	f, ok := formats[*format]
+	if !ok || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var src io.Reader = os.Stdin
+	if flag.NArg() == 1 {
+		h, err := os.Open(flag.Arg(0))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		defer h.Close()
+		src = h
+	}
+	p, err := cedict.New(src,
+		cedict.WithCompression(cedict.AutoDetect),
+		cedict.StripBOM(),
+		cedict.WithFormat(f),
+		cedict.Lenient())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	found := false
+	err = lint(p, func(prob Problem) {
+		found = true
+		enc.Encode(prob)
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if found {
+		os.Exit(1)
+	}
+}
+
+// lint checks every line yielded by a lenient parser and reports the
+// problems in order.
+func lint(p *cedict.Parser, report func(Problem)) error {
+	l := linter{
+		seen:   make(map[string]int),
+		report: report,
+	}
+	reported := 0
+	for {
+		ln, err := p.Next()
+		if err != nil {
+			return err
+		}
+		// report the lines skipped by the parser
+		for _, perr := range p.Errors()[reported:] {
+			report(Problem{
+				Line:    perr.Line,
+				Check:   "syntax",
+				Message: fmt.Sprintf("%s: %q", perr.Reason, perr.Raw),
+			})
+		}
+		reported = len(p.Errors())
+		if ln == nil {
+			return nil
+		}
+		if e, ok := ln.(cedict.Entry); ok {
+			l.check(p.Line(), e)
+		}
+	}
+}
+
+type linter struct {
+	// first line of every entry, by its words and pinyin
+	seen   map[string]int
+	report func(Problem)
+}
+
+func (l *linter) problem(line int, check, format string, args ...interface{}) {
+	l.report(Problem{
+		Line:    line,
+		Check:   check,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) check(line int, e cedict.Entry) {
+	trad := []rune(e.Traditional)
+	simp := []rune(e.Simplified)
+	if len(trad) != len(simp) {
+		l.problem(line, "length",
+			"traditional %q has %d characters, simplified %q has %d",
+			e.Traditional, len(trad), e.Simplified, len(simp))
+	}
+	// a dash separates spelled out latin letters from the pinyin
+	var syllables []cedict.Pinyin
+	for _, p := range e.Pinyin {
+		if p.Literal != "-" {
+			syllables = append(syllables, p)
+		}
+	}
+	if len(syllables) != len(trad) {
+		l.problem(line, "pinyin-count",
+			"%d pinyin for %d characters", len(syllables), len(trad))
+	}
+	for i, p := range syllables {
+		if p.Literal == "" || !isPinyin(trad, i) || isPunctuation(p.Literal) {
+			continue
+		}
+		l.problem(line, "pinyin", "invalid pinyin %q", p.Literal)
+	}
+	for i, m := range e.Meaning {
+		if strings.TrimSpace(m) == "" {
+			l.problem(line, "empty-gloss", "gloss %d is empty", i+1)
+		}
+	}
+	if len(trad) == len(simp) {
+		for i, r := range trad {
+			repl, ok := simplified.Replacements[r]
+			if ok && simp[i] != r && simp[i] != repl &&
+				!strings.ContainsRune(alternatives[r], simp[i]) {
+				l.problem(line, "simplified",
+					"%q is simplified to %q instead of %q",
+					r, simp[i], repl)
+			}
+		}
+	}
+	pins := make([]string, len(e.Pinyin))
+	for i, p := range e.Pinyin {
+		pins[i] = p.String()
+	}
+	key := e.Traditional + " " + e.Simplified + " " + strings.Join(pins, " ")
+	if first, ok := l.seen[key]; ok {
+		l.problem(line, "duplicate", "duplicate of line %d", first)
+	} else {
+		l.seen[key] = line
+	}
+}
+
+// isPinyin returns whether the i-th pinyin of a word has to be a
+// proper pinyin syllable, which is not the case for latin letters and
+// digits that are spelled out as they are, or if the pinyin can't be
+// attributed to a character.
+func isPinyin(word []rune, i int) bool {
+	if i >= len(word) {
+		return false
+	}
+	return unicode.Is(unicode.Han, word[i])
+}
+
+func isPunctuation(str string) bool {
+	for _, r := range str {
+		if !unicode.IsPunct(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+func TestLint(t *testing.T) {
+	input := "# comment\n" +
+		"做事 做事 [zuo4 shi4] /to work/\n" +
+		"做事 做事 [zuo4 shi4] /to handle matters/\n" +
+		"做事 做 [zuo4 shi4] /to work/\n" +
+		"做事 做事 [zuo4] /to work/\n" +
+		"做事 做事 [zuo4 xx5] /to work/\n" +
+		"做事 做事 [zuo4 shi4] //to work/\n" +
+		"餘 余 [yu2] /extra/\n" +
+		"什麼 什么 [shen2 me5] /what?/\n" +
+		"書 画 [shu1] /book/\n" +
+		"A片 A片 [A - pian4] /adult movie/\n" +
+		"broken\n"
+	p, err := cedict.New(bytes.NewReader([]byte(input)),
+		cedict.WithCompression(cedict.Uncompressed), cedict.Lenient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var problems []string
+	err = lint(p, func(prob Problem) {
+		problems = append(problems,
+			fmt.Sprintf("%d %s", prob.Line, prob.Check))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"3 duplicate",
+		"4 length",
+		"5 pinyin-count",
+		"6 pinyin",
+		"7 empty-gloss",
+		"7 duplicate",
+		"10 simplified",
+		"12 syntax",
+	}
+	if strings.Join(problems, ",") != strings.Join(expected, ",") {
+		t.Errorf("wrong problems: %q", problems)
+	}
+}
@@ -11,26 +11,11 @@ import (
 )
 
 func main() {
-	f, err := os.Open("sounds.txt")
-	if err != nil {
-		panic(err)
-	}
+	sounds := readLines("sounds.txt")
 	h, err := os.Create("gen.go")
 	if err != nil {
 		panic(err)
 	}
-	var sounds []string
-	sc := bufio.NewScanner(f)
-	for sc.Scan() {
-		ln := sc.Text()
-		if strings.HasPrefix(ln, "#") {
-			continue
-		}
-		sounds = append(sounds, ln)
-	}
-	if err := sc.Err(); err != nil {
-		panic(err)
-	}
 	var tree node
 	for _, sound := range sounds {
 		tree.insert([]rune(sound))
@@ -100,9 +85,288 @@ func main() {
 		"\t\treturn false\n"+
 		"\t}\n"+
 		"}\n")
+	writeSyllables(h, sounds)
+	for _, t := range tables {
+		writeTable(h, sounds, t.File, t.Name, !t.RenderOnly)
+	}
+	fmt.Fprintf(h, "\nconst numSounds = %d\n", len(sounds))
 	h.Close()
 }
 
+// initials are the initials of pinyin, longer ones first.
+var initials = []string{
+	"zh", "ch", "sh",
+	"b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h",
+	"j", "q", "x", "r", "z", "c", "s",
+}
+
+// zeroInitial maps the spellings of sounds without initial to their
+// final, as far as they differ.
+var zeroInitial = map[string]string{
+	"yi": "i", "ya": "ia", "yo": "io", "ye": "ie", "yao": "iao",
+	"you": "iu", "yan": "ian", "yin": "in", "yang": "iang",
+	"ying": "ing", "yong": "iong",
+	"wu": "u", "wa": "ua", "wo": "uo", "wai": "uai", "wei": "ui",
+	"wan": "uan", "wen": "un", "wang": "uang", "weng": "ueng",
+	"wong": "ong",
+
+	"yu": "ü", "yue": "üe", "yuan": "üan", "yun": "ün",
+}
+
+// split splits the spelling of a sound into initial and final. The
+// final is spelled as after a consonant, with u written as ü after j,
+// q and x. Syllabic nasals like m or ng are finals.
+func split(sound string) (string, string) {
+	if final, ok := zeroInitial[sound]; ok {
+		return "", final
+	}
+	for _, initial := range initials {
+		if len(sound) <= len(initial) || !strings.HasPrefix(sound, initial) {
+			continue
+		}
+		final := sound[len(initial):]
+		// finals start with a vowel, or are syllabic nasals like in hm
+		if !strings.ContainsRune("aeiouü", []rune(final)[0]) &&
+			final != "m" && final != "n" && final != "ng" {
+			continue
+		}
+		switch initial {
+		case "j", "q", "x":
+			if final[0] == 'u' {
+				final = "ü" + final[1:]
+			}
+		}
+		return initial, final
+	}
+	return "", sound
+}
+
+// writeSyllables writes the Initial and Final enumerations, the
+// decomposition of sounds into them and the function composing them.
+func writeSyllables(h io.Writer, sounds []string) {
+	inits := append([]string{""}, initials...)
+	var finals []string
+	seenFinal := make(map[string]bool)
+	composed := make(map[[2]string]string)
+	for _, sound := range sounds {
+		initial, final := split(sound)
+		if !seenFinal[final] {
+			seenFinal[final] = true
+			finals = append(finals, final)
+		}
+		key := [2]string{initial, final}
+		if other, ok := composed[key]; ok {
+			panic(fmt.Sprintf("%q and %q decompose alike", other, sound))
+		}
+		composed[key] = sound
+	}
+	initName := func(initial string) string {
+		if initial == "" {
+			return "NoInitial"
+		}
+		return "Initial" + strings.ToUpper(initial)
+	}
+	finalName := func(final string) string {
+		return "Final" + strings.ToUpper(final)
+	}
+	fmt.Fprintf(h, "\nconst (\n")
+	for i, initial := range inits {
+		fmt.Fprintf(h, "\t%s", initName(initial))
+		if i == 0 {
+			fmt.Fprintf(h, " Initial = iota")
+		}
+		fmt.Fprintf(h, "\n")
+	}
+	fmt.Fprintf(h, ")\n\n"+
+		"func (p Initial) String() string {\n"+
+		"\tswitch p {\n")
+	for _, initial := range inits {
+		str := initial
+		if str == "" {
+			str = "∅"
+		}
+		fmt.Fprintf(h, "\tcase %s:\n"+
+			"\t\treturn %q\n",
+			initName(initial), str)
+	}
+	fmt.Fprintf(h, "\tdefault:\n"+
+		"\t\treturn \"?\"\n"+
+		"\t}\n"+
+		"}\n\n"+
+		"const (\n")
+	for i, final := range finals {
+		fmt.Fprintf(h, "\t%s", finalName(final))
+		if i == 0 {
+			fmt.Fprintf(h, " Final = iota")
+		}
+		fmt.Fprintf(h, "\n")
+	}
+	fmt.Fprintf(h, ")\n\n"+
+		"func (p Final) String() string {\n"+
+		"\tswitch p {\n")
+	for _, final := range finals {
+		fmt.Fprintf(h, "\tcase %s:\n"+
+			"\t\treturn %q\n",
+			finalName(final), final)
+	}
+	fmt.Fprintf(h, "\tdefault:\n"+
+		"\t\treturn \"?\"\n"+
+		"\t}\n"+
+		"}\n\n"+
+		"// Initial returns the initial of a sound.\n"+
+		"func (p Sound) Initial() Initial {\n"+
+		"\tswitch p {\n")
+	for _, sound := range sounds {
+		initial, _ := split(sound)
+		if initial == "" {
+			continue
+		}
+		fmt.Fprintf(h, "\tcase %s:\n"+
+			"\t\treturn %s\n",
+			strings.ToUpper(sound), initName(initial))
+	}
+	fmt.Fprintf(h, "\tdefault:\n"+
+		"\t\treturn NoInitial\n"+
+		"\t}\n"+
+		"}\n\n"+
+		"// Final returns the final of a sound. It's spelled as after a\n"+
+		"// consonant, e.g. the final of you is iu and the final of ju is ü.\n"+
+		"func (p Sound) Final() Final {\n"+
+		"\tswitch p {\n")
+	for _, sound := range sounds {
+		_, final := split(sound)
+		fmt.Fprintf(h, "\tcase %s:\n"+
+			"\t\treturn %s\n",
+			strings.ToUpper(sound), finalName(final))
+	}
+	fmt.Fprintf(h, "\tdefault:\n"+
+		"\t\treturn 0\n"+
+		"\t}\n"+
+		"}\n\n"+
+		"// Compose returns the sound made up of an initial and a final, or\n"+
+		"// false if there is none.\n"+
+		"func Compose(i Initial, f Final) (Sound, bool) {\n"+
+		"\tswitch i {\n")
+	for _, initial := range inits {
+		var cases []string
+		for _, final := range finals {
+			if sound, ok := composed[[2]string{initial, final}]; ok {
+				cases = append(cases, fmt.Sprintf("\t\tcase %s:\n"+
+					"\t\t\treturn %s, true\n",
+					finalName(final), strings.ToUpper(sound)))
+			}
+		}
+		fmt.Fprintf(h, "\tcase %s:\n"+
+			"\t\tswitch f {\n"+
+			"%s"+
+			"\t\t}\n",
+			initName(initial), strings.Join(cases, ""))
+	}
+	fmt.Fprintf(h, "\t}\n"+
+		"\treturn 0, false\n"+
+		"}\n")
+}
+
+// tables of the spellings of the sounds in other romanizations.
+var tables = []struct {
+	File       string
+	Name       string
+	RenderOnly bool
+}{
+	{"zhuyin.txt", "zhuyin", false},
+	{"wadegiles.txt", "wadeGiles", false},
+	{"yale.txt", "yale", false},
+	{"gr.txt", "gr", false},
+	{"ipa.txt", "ipa", true},
+}
+
+// writeTable writes a method rendering a sound in a romanization and,
+// if parse is set, a function parsing a spelling and the maximal
+// length of a spelling. The first spelling of a sound in the table is
+// rendered, further spellings are only parsed. If sounds share a
+// spelling, it's parsed as the first one.
+func writeTable(h io.Writer, sounds []string, file, name string, parse bool) {
+	type spelling struct {
+		Sound    string
+		Spelling string
+	}
+	var spellings []spelling
+	rendered := make(map[string]string)
+	for _, ln := range readLines(file) {
+		sound, sp, ok := strings.Cut(ln, " ")
+		if !ok {
+			panic(fmt.Sprintf("invalid line %q in %s", ln, file))
+		}
+		spellings = append(spellings, spelling{sound, sp})
+		if _, ok := rendered[sound]; !ok {
+			rendered[sound] = sp
+		}
+	}
+	fmt.Fprintf(h, "\nfunc (p Sound) %s() string {\n"+
+		"\tswitch p {\n", name)
+	for _, sound := range sounds {
+		sp, ok := rendered[sound]
+		if !ok {
+			panic(fmt.Sprintf("no spelling for %q in %s", sound, file))
+		}
+		fmt.Fprintf(h, "\tcase %s:\n"+
+			"\t\treturn %q\n",
+			strings.ToUpper(sound), sp)
+	}
+	fmt.Fprintf(h, "\tdefault:\n"+
+		"\t\treturn \"?\"\n"+
+		"\t}\n"+
+		"}\n")
+	if !parse {
+		return
+	}
+	fmt.Fprintf(h, "\n"+
+		"func %sSound(str string) (bool, Sound) {\n"+
+		"\tswitch str {\n", name)
+	seen := make(map[string]bool)
+	maxLen := 0
+	for _, sp := range spellings {
+		if seen[sp.Spelling] {
+			continue
+		}
+		seen[sp.Spelling] = true
+		if l := len([]rune(sp.Spelling)); l > maxLen {
+			maxLen = l
+		}
+		fmt.Fprintf(h, "\tcase %q:\n"+
+			"\t\treturn true, %s\n",
+			sp.Spelling, strings.ToUpper(sp.Sound))
+	}
+	fmt.Fprintf(h, "\tdefault:\n"+
+		"\t\treturn false, 0\n"+
+		"\t}\n"+
+		"}\n\n"+
+		"const max%s%sLength = %d\n",
+		strings.ToUpper(name[:1]), name[1:], maxLen)
+}
+
+// readLines reads the lines of a file, skipping comments.
+func readLines(name string) []string {
+	f, err := os.Open(name)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	var lines []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		ln := sc.Text()
+		if strings.HasPrefix(ln, "#") {
+			continue
+		}
+		lines = append(lines, ln)
+	}
+	if err := sc.Err(); err != nil {
+		panic(err)
+	}
+	return lines
+}
+
 type node struct {
 	Next []nodeNext
 }
@@ -0,0 +1,95 @@
+package dict
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hgoes/hanyu/cedict"
+	"github.com/hgoes/hanyu/internal/builder"
+)
+
+// Builder creates a dictionary in memory from CEDICT entries, the
+// same way [Main] is generated.
+type Builder struct {
+	b builder.Builder
+}
+
+// NewBuilder creates a new, empty [Builder]. The HSK levels of words
+// are taken from a map of simplified words to levels, which may be
+// nil.
+func NewBuilder(hsk map[string]byte) *Builder {
+	return &Builder{
+		b: builder.Builder{
+			HSK: hsk,
+		},
+	}
+}
+
+// Add an entry to the dictionary.
+func (b *Builder) Add(e cedict.Entry) error {
+	if e.Traditional == "" {
+		return fmt.Errorf("entry without a word")
+	}
+	if utf8.RuneCountInString(e.Traditional) !=
+		utf8.RuneCountInString(e.Simplified) {
+		return fmt.Errorf(
+			"traditional %q and simplified %q differ in length",
+			e.Traditional, e.Simplified)
+	}
+	b.b.Add(e)
+	return nil
+}
+
+// AddAll adds all entries yielded by a [cedict.Parser].
+func (b *Builder) AddAll(p *cedict.Parser) error {
+	for {
+		ln, err := p.Next()
+		if err != nil {
+			return err
+		}
+		switch sub := ln.(type) {
+		case nil:
+			return nil
+		case cedict.Entry:
+			if err := b.Add(sub); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Build creates the dictionary from all added entries.
+func (b *Builder) Build() (d *Dict, err error) {
+	defer func() {
+		// the encoder panics if limits of the format are exceeded
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to encode dictionary: %v", r)
+		}
+	}()
+	bin, err := b.b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return fromBinary(bin), nil
+}
+
+// Build creates a dictionary from all entries yielded by a
+// [cedict.Parser].
+func Build(p *cedict.Parser) (*Dict, error) {
+	b := NewBuilder(nil)
+	if err := b.AddAll(p); err != nil {
+		return nil, err
+	}
+	return b.Build()
+}
+
+// BuildEntries creates a dictionary from a list of entries.
+func BuildEntries(entries []cedict.Entry) (*Dict, error) {
+	b := NewBuilder(nil)
+	for _, e := range entries {
+		if err := b.Add(e); err != nil {
+			return nil, err
+		}
+	}
+	return b.Build()
+}
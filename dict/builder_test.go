@@ -0,0 +1,93 @@
+package dict
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+func TestBuild(t *testing.T) {
+	input := "# glossary\n" +
+		"顯示卡 显示卡 [xian3 shi4 ka3] /graphics card/\n" +
+		"顯示 显示 [xian3 shi4] /to show/to display/\n" +
+		"卡 卡 [ka3] /card/\n"
+	var buf bytes.Buffer
+	wr := gzip.NewWriter(&buf)
+	wr.Write([]byte(input))
+	wr.Close()
+	p, err := cedict.New(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := Build(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, m := d.Lookup([]rune("显示卡坏了"))
+	if l != 3 {
+		t.Fatal("wrong length:", l)
+	}
+	if len(m) != 1 || m[0].Meanings[0] != "graphics card" {
+		t.Fatalf("wrong meaning: %+v", m)
+	}
+	if m[0].Traditional != "顯示卡" {
+		t.Errorf("wrong traditional: %q", m[0].Traditional)
+	}
+	res := d.SearchEnglish("display")
+	if len(res) != 1 || res[0].Simplified != "显示" {
+		t.Errorf("wrong english search result: %+v", res)
+	}
+	res = d.SearchPinyin("ka3", 0)
+	if len(res) != 1 || res[0].Simplified != "卡" {
+		t.Errorf("wrong pinyin search result: %+v", res)
+	}
+}
+
+func TestBuildEntries(t *testing.T) {
+	_, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "顯示",
+			Simplified:  "显",
+		},
+	})
+	if err == nil {
+		t.Error("expected an error for differing lengths")
+	}
+	d, err := BuildEntries(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l, _ := d.Lookup([]rune("卡")); l != 0 {
+		t.Error("empty dictionary has a match")
+	}
+}
+
+func TestBuildCapitalized(t *testing.T) {
+	input := "北京 北京 [Bei3 jing1] /Beijing/\n" +
+		"A片 A片 [A pian4] /pornographic movie/\n"
+	var buf bytes.Buffer
+	wr := gzip.NewWriter(&buf)
+	wr.Write([]byte(input))
+	wr.Close()
+	p, err := cedict.New(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := Build(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, m := d.Lookup([]rune("北京"))
+	if len(m) != 1 || len(m[0].Pinyin) != 2 {
+		t.Fatalf("wrong meaning: %+v", m)
+	}
+	if !m[0].Pinyin[0].Capitalized || m[0].Pinyin[1].Capitalized {
+		t.Errorf("wrong capitalization: %+v", m[0].Pinyin)
+	}
+	_, m = d.Lookup([]rune("A片"))
+	if len(m) != 1 || m[0].Pinyin[0].Literal != "A" {
+		t.Fatalf("wrong meaning: %+v", m)
+	}
+}
@@ -0,0 +1,153 @@
+package dict
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/hgoes/hanyu/numbers"
+)
+
+// ClassifierNouns returns the meanings of all words that list the
+// given classifier (in simplified or traditional writing, e.g. 条 or
+// 張), HSK words first. The Simplified and Traditional fields of the
+// results are always set.
+func (d *Dict) ClassifierNouns(classifier string) []Meaning {
+	idxs := postings(d.classifier, classifier)
+	if len(idxs) == 0 {
+		return nil
+	}
+	meanings := make([]Meaning, len(idxs))
+	for i, idx := range idxs {
+		meanings[i] = d.meaningAt(idx)
+	}
+	sort.SliceStable(meanings, func(i, j int) bool {
+		return hskRank(meanings[i].HSKLevel) < hskRank(meanings[j].HSKLevel)
+	})
+	return meanings
+}
+
+// IsClassifier returns whether a word is listed as a classifier of
+// any word in the dictionary.
+func (d *Dict) IsClassifier(word string) bool {
+	return len(postings(d.classifier, word)) != 0
+}
+
+// Measure is a numeral followed by a classifier and a noun, like
+// 三本书. Start and End are the rune offsets in the segmented text.
+type Measure struct {
+	Start      int
+	End        int
+	Numeral    string
+	Value      int64
+	Classifier string
+	Noun       string
+	// Meanings of the noun that list classifiers.
+	Meanings []Meaning
+	// Correct reports whether one of the meanings lists the
+	// classifier.
+	Correct bool
+}
+
+// Measures finds every numeral followed by a classifier and a noun in
+// a text split by [Dict.Segment] or [Dict.SegmentBest], and checks
+// whether the classifier goes with the noun. Since segmentation can
+// merge a numeral with its classifier (一个) or a classifier with the
+// noun (本书), the classifier and the noun are looked up again after
+// every numeral. Only nouns that list classifiers are considered.
+func (d *Dict) Measures(tokens []Token) []Measure {
+	if len(tokens) == 0 {
+		return nil
+	}
+	base := tokens[0].Start
+	var runes []rune
+	for _, tok := range tokens {
+		runes = append(runes, []rune(tok.Text)...)
+	}
+	var result []Measure
+	end := base
+	for _, tok := range tokens {
+		if tok.Start < end {
+			// already part of a measure
+			continue
+		}
+		l, value := numeralPrefix(tok)
+		if l == 0 {
+			continue
+		}
+		m, ok := d.measure(runes[tok.Start-base:], l)
+		if !ok {
+			continue
+		}
+		m.Start += tok.Start
+		m.End += tok.Start
+		m.Value = value
+		result = append(result, m)
+		end = m.End
+	}
+	return result
+}
+
+// measure checks for a classifier and a noun after the numeral of the
+// given length at the start of str.
+func (d *Dict) measure(str []rune, numeral int) (Measure, bool) {
+	rest := str[numeral:]
+	classifier := 0
+	d.Prefixes(rest, func(l int, _ []Meaning) bool {
+		if d.IsClassifier(string(rest[:l])) {
+			classifier = l
+		}
+		return true
+	})
+	if classifier == 0 {
+		return Measure{}, false
+	}
+	cl := string(rest[:classifier])
+	l, meanings := d.Lookup(rest[classifier:])
+	if l == 0 {
+		return Measure{}, false
+	}
+	m := Measure{
+		End:        numeral + classifier + l,
+		Numeral:    string(str[:numeral]),
+		Classifier: cl,
+		Noun:       string(rest[classifier : classifier+l]),
+	}
+	for _, mean := range meanings {
+		if len(mean.Classifiers) == 0 {
+			continue
+		}
+		m.Meanings = append(m.Meanings, mean)
+		for _, c := range mean.Classifiers {
+			if c.Simplified == cl || c.Traditional == cl {
+				m.Correct = true
+			}
+		}
+	}
+	return m, len(m.Meanings) != 0
+}
+
+// numeralPrefix returns the length and value of the numeral at the
+// start of a token.
+func numeralPrefix(tok Token) (int, int64) {
+	if tok.Kind == Digit {
+		value, err := strconv.ParseInt(tok.Text, 10, 64)
+		if err != nil {
+			return 0, 0
+		}
+		return len([]rune(tok.Text)), value
+	}
+	if tok.Kind != Word {
+		return 0, 0
+	}
+	runes := []rune(tok.Text)
+	if runes[0] == '两' || runes[0] == '兩' {
+		// only used as a numeral in front of classifiers
+		return 1, 2
+	}
+	var p numbers.Parser
+	l := 0
+	for l < len(runes) && p.Consume(runes[l]) {
+		l++
+	}
+	return l, p.Value()
+}
@@ -0,0 +1,100 @@
+package dict
+
+import (
+	"testing"
+)
+
+func TestClassifierNouns(t *testing.T) {
+	tests := []struct {
+		Classifier string
+		Noun       string
+	}{
+		{
+			Classifier: "条",
+			Noun:       "狗",
+		},
+		{
+			Classifier: "張",
+			Noun:       "桌子",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Classifier, func(t *testing.T) {
+			found := false
+			for _, m := range Main.ClassifierNouns(test.Classifier) {
+				if m.Simplified == test.Noun {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s not found", test.Noun)
+			}
+		})
+	}
+	if res := Main.ClassifierNouns("狗"); len(res) != 0 {
+		t.Errorf("wrong number of nouns: %d", len(res))
+	}
+}
+
+func TestMeasures(t *testing.T) {
+	tests := []struct {
+		Input      string
+		Value      int64
+		Classifier string
+		Noun       string
+		Correct    bool
+	}{
+		{
+			Input:      "我有三本书",
+			Value:      3,
+			Classifier: "本",
+			Noun:       "书",
+			Correct:    true,
+		},
+		{
+			Input:      "他买了两张桌子",
+			Value:      2,
+			Classifier: "张",
+			Noun:       "桌子",
+			Correct:    true,
+		},
+		{
+			Input:      "我看了5条狗",
+			Value:      5,
+			Classifier: "条",
+			Noun:       "狗",
+			Correct:    true,
+		},
+		{
+			Input:      "一张书",
+			Value:      1,
+			Classifier: "张",
+			Noun:       "书",
+			Correct:    false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			res := Main.Measures(Main.SegmentBest(test.Input))
+			if len(res) != 1 {
+				t.Fatalf("wrong number of measures: %d", len(res))
+			}
+			m := res[0]
+			if m.Value != test.Value {
+				t.Errorf("wrong value: %d", m.Value)
+			}
+			if m.Classifier != test.Classifier {
+				t.Errorf("wrong classifier: %q", m.Classifier)
+			}
+			if m.Noun != test.Noun {
+				t.Errorf("wrong noun: %q", m.Noun)
+			}
+			if m.Correct != test.Correct {
+				t.Errorf("wrong correctness: %v", m.Correct)
+			}
+			if m.End != len([]rune(test.Input)) {
+				t.Errorf("wrong end: %d", m.End)
+			}
+		})
+	}
+}
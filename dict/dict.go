@@ -14,26 +14,50 @@ import (
 
 // Meaning of a chinese word, containing the pinyin pronunciation,
 // meanings, the HSK level of the word (0 means it is not in the HSK)
-// and simplified as well as traditional writing.
+// and simplified as well as traditional writing. The classifiers,
+// references and flags are parsed from the meanings (see
+// [cedict.Entry]).
 type Meaning struct {
 	Pinyin      []cedict.Pinyin
 	Meanings    []string
 	HSKLevel    byte
 	Simplified  string
 	Traditional string
+	Classifiers []cedict.Word
+	References  []cedict.Reference
+	Flags       cedict.Flags
 }
 
 //go:embed gen.bin
 var dict []byte
 
 // Main is the default dictionary, generated from CEDICT.
-var Main = Dict{
-	bin: dict,
+var Main = func() Dict {
+	d, err := fromBytes(dict)
+	if err != nil {
+		panic(err)
+	}
+	return *d
+}()
+
+// Dictionary is implemented by every kind of dictionary that chinese
+// words can be looked up in, i.e. [Dict] and [Layered].
+type Dictionary interface {
+	// Lookup looks up the longest word at the start of str. Returns
+	// the number of characters consumed and every potential
+	// meaning.
+	Lookup(str []rune) (int, []Meaning)
+	// Prefixes calls f for every word that is a prefix of str,
+	// shortest first, until f returns false.
+	Prefixes(str []rune, f func(l int, m []Meaning) bool)
 }
 
 // Dict is a dictionary, capable of looking up chinese words.
 type Dict struct {
-	bin []byte
+	bin        []byte
+	english    []byte
+	pinyin     []byte
+	classifier []byte
 }
 
 func uint24(data []byte) uint32 {
@@ -59,9 +83,7 @@ func (d *Dict) Lookup(
 		if !l.Consume(c) {
 			break
 		}
-		if unicode.Is(unicode.Latin, c) &&
-			i+1 < len(str) &&
-			unicode.Is(unicode.Latin, str[i+1]) {
+		if partialLatin(str, i) {
 			// avoid matching partial latin words
 			continue
 		}
@@ -79,10 +101,7 @@ func (d *Dict) Lookup(
 			if !l.Consume(c) {
 				break
 			}
-			if unicode.Is(unicode.Latin, c) &&
-				i+1 < len(str) &&
-				unicode.Is(unicode.Latin, str[i+1]) {
-				// avoid matching partial latin words
+			if partialLatin(str[1:], i) {
 				continue
 			}
 			if l.IsWord() && i >= lastWordLen-1 {
@@ -93,6 +112,31 @@ func (d *Dict) Lookup(
 	return lastWordLen, lastWord.Meanings(str[:lastWordLen])
 }
 
+// Prefixes calls f for every word in the dictionary that is a prefix
+// of str, shortest first, until f returns false.
+func (d *Dict) Prefixes(str []rune, f func(l int, m []Meaning) bool) {
+	l := d.Begin()
+	for i, c := range str {
+		if !l.Consume(c) {
+			return
+		}
+		if partialLatin(str, i) {
+			continue
+		}
+		if l.IsWord() && !f(i+1, l.Meanings(str[:i+1])) {
+			return
+		}
+	}
+}
+
+// partialLatin returns whether the character at position i of str is
+// a latin letter followed by another one.
+func partialLatin(str []rune, i int) bool {
+	return unicode.Is(unicode.Latin, str[i]) &&
+		i+1 < len(str) &&
+		unicode.Is(unicode.Latin, str[i+1])
+}
+
 func isSoftMatch(r rune) bool {
 	switch r {
 	case '不', '在', '有', '没':
@@ -169,73 +213,141 @@ func (cur *Lookup) Meanings(word []rune) []Meaning {
 	if lenMeanings == 0 {
 		return nil
 	}
-	// read the rune index length
-	runeIdxLen := uint24(cur.dict)
-	// read the meanings offset
-	offset := uint24(cur.dict[3+3*runeIdxLen:]) + 6 + 3*runeIdxLen
-
 	meanings := make([]Meaning, lenMeanings)
 	for i := range meanings {
 		idx := uint24(cur.dict[cur.meanings+1+i*3:])
-		meaningOffset := int(uint24(cur.dict[int(offset)+int(idx)*3:]))
-		pos := int(offset) + meaningOffset
-		hsk := cur.dict[pos]
-		meanings[i].HSKLevel = hsk
-		pos += 1
-		pinSz := cur.dict[pos]
-		pos += 1
-		var pinyins []cedict.Pinyin
-		if pinSz != 0 {
-			pinyins = make([]cedict.Pinyin, pinSz)
-		}
-		for j := range pinyins {
-			b := cur.dict[pos]
-			if b > 127 {
-				// it's a regular pinyin
-				raw := binary.BigEndian.Uint16(cur.dict[pos:]) & 0x7FFF
-				pinyins[j].Pinyin = pinyin.Pinyin(raw)
-				pos += 2
-			} else {
-				// it's a literal pinyin
-				pos++
-				str := string(cur.dict[pos : pos+int(b)])
-				pinyins[j].Literal = str
-				pos += int(b)
-			}
-		}
-		meanings[i].Pinyin = pinyins
-		var means []string
-		meansSize := cur.dict[pos]
-		pos++
-		if meansSize != 0 {
-			means = make([]string, meansSize)
-		}
-		for j := range means {
-			l := binary.BigEndian.Uint16(cur.dict[pos:])
+		meanings[i], _ = decodeMeaning(cur.dict, idx, word)
+	}
+	return meanings
+}
+
+// meaningAt decodes the meaning with the given index, with both the
+// Simplified and Traditional fields set.
+func (d *Dict) meaningAt(idx uint32) Meaning {
+	m, word := decodeMeaning(d.bin, idx, nil)
+	if m.Traditional == "" {
+		m.Traditional = string(word)
+		m.Simplified = string(word)
+	}
+	return m
+}
+
+// decodeMeaning decodes the meaning with the given index. If word is
+// nil, the headword stored alongside the meaning is used. Returns the
+// meaning and the word that was used.
+func decodeMeaning(dict []byte, idx uint32, word []rune) (Meaning, []rune) {
+	var meaning Meaning
+	// read the rune index length
+	runeIdxLen := uint24(dict)
+	// read the meanings offset
+	offset := uint24(dict[3+3*runeIdxLen:]) + 6 + 3*runeIdxLen
+
+	meaningOffset := int(uint24(dict[int(offset)+int(idx)*3:]))
+	pos := int(offset) + meaningOffset
+	hsk := dict[pos]
+	meaning.HSKLevel = hsk
+	pos += 1
+	meaning.Pinyin, pos = decodePinyins(dict, pos)
+	var means []string
+	meansSize := dict[pos]
+	pos++
+	if meansSize != 0 {
+		means = make([]string, meansSize)
+	}
+	for j := range means {
+		l := binary.BigEndian.Uint16(dict[pos:])
+		pos += 2
+		means[j] = string(dict[pos : pos+int(l)])
+		pos += int(l)
+	}
+	meaning.Meanings = means
+	varSize := dict[pos]
+	pos++
+	variants := dict[pos : pos+int(varSize)*5]
+	pos += len(variants)
+	// read the headword
+	headLen := int(dict[pos])
+	if word == nil {
+		word = []rune(string(dict[pos+1 : pos+1+headLen]))
+	}
+	pos += 1 + headLen
+	meaning.Flags = cedict.Flags(dict[pos])
+	pos++
+	clSize := dict[pos]
+	pos++
+	if clSize != 0 {
+		meaning.Classifiers = make([]cedict.Word, clSize)
+	}
+	for j := range meaning.Classifiers {
+		meaning.Classifiers[j], pos = decodeWord(dict, pos)
+	}
+	refSize := dict[pos]
+	pos++
+	if refSize != 0 {
+		meaning.References = make([]cedict.Reference, refSize)
+	}
+	for j := range meaning.References {
+		meaning.References[j].Kind = cedict.RefKind(dict[pos])
+		meaning.References[j].Word, pos = decodeWord(dict, pos+1)
+	}
+	var simp, trad []rune
+	if varSize != 0 {
+		simp = make([]rune, len(word))
+		copy(simp, word)
+		trad = make([]rune, len(word))
+		copy(trad, word)
+	}
+	for j := 0; j < len(variants); j += 5 {
+		cpos := variants[j]
+		trad[cpos] = decodeRune(dict, binary.BigEndian.Uint16(variants[j+1:]))
+		simp[cpos] = decodeRune(dict, binary.BigEndian.Uint16(variants[j+3:]))
+	}
+	meaning.Simplified = string(simp)
+	meaning.Traditional = string(trad)
+	return meaning, word
+}
+
+// decodePinyins decodes a list of pinyins at pos. Returns the pinyins
+// and the position after them.
+func decodePinyins(dict []byte, pos int) ([]cedict.Pinyin, int) {
+	pinSz := dict[pos]
+	pos += 1
+	var pinyins []cedict.Pinyin
+	if pinSz != 0 {
+		pinyins = make([]cedict.Pinyin, pinSz)
+	}
+	for j := range pinyins {
+		b := dict[pos]
+		if b > 127 {
+			// it's a regular pinyin
+			raw := binary.BigEndian.Uint16(dict[pos:])
+			pinyins[j].Pinyin = pinyin.Pinyin(raw & 0x3FFF)
+			pinyins[j].Capitalized = raw&0x4000 != 0
 			pos += 2
-			means[j] = string(cur.dict[pos : pos+int(l)])
-			pos += int(l)
-		}
-		meanings[i].Meanings = means
-		varSize := cur.dict[pos]
-		pos++
-		var simp, trad []rune
-		if varSize != 0 {
-			simp = make([]rune, len(word))
-			copy(simp, word)
-			trad = make([]rune, len(word))
-			copy(trad, word)
-		}
-		for j := byte(0); j < varSize; j++ {
-			cpos := cur.dict[pos]
+		} else {
+			// it's a literal pinyin
 			pos++
-			trad[cpos] = decodeRune(cur.dict, binary.BigEndian.Uint16(cur.dict[pos:]))
-			pos += 2
-			simp[cpos] = decodeRune(cur.dict, binary.BigEndian.Uint16(cur.dict[pos:]))
-			pos += 2
+			str := string(dict[pos : pos+int(b)])
+			pinyins[j].Literal = str
+			pos += int(b)
 		}
-		meanings[i].Simplified = string(simp)
-		meanings[i].Traditional = string(trad)
 	}
-	return meanings
+	return pinyins, pos
+}
+
+// decodeWord decodes a word referenced by a meaning at pos. Returns
+// the word and the position after it.
+func decodeWord(dict []byte, pos int) (cedict.Word, int) {
+	var w cedict.Word
+	l := int(dict[pos])
+	w.Traditional = string(dict[pos+1 : pos+1+l])
+	pos += 1 + l
+	l = int(dict[pos])
+	w.Simplified = string(dict[pos+1 : pos+1+l])
+	pos += 1 + l
+	if w.Simplified == "" {
+		w.Simplified = w.Traditional
+	}
+	w.Pinyin, pos = decodePinyins(dict, pos)
+	return w, pos
 }
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hgoes/hanyu/cedict"
 	"github.com/hgoes/hanyu/pinyin"
 )
 
@@ -56,6 +57,11 @@ func TestLookup(t *testing.T) {
 			Input:   "不复杂",
 			Meaning: "no; not so/(bound form) not; un-/",
 		},
+		{
+			// 大V is a word, even though it ends in latin
+			Input:   "不大V的",
+			Meaning: "no; not so/(bound form) not; un-/",
+		},
 		{
 			Input:   "做",
 			Meaning: "to make; to produce/to write; to compose/to do; to engage in; to hold (a party etc)/(of a person) to be (an intermediary, a good student etc); to become (husband and wife, friends etc)/(of a thing) to serve as; to be used for/to assume (an air or manner)/",
@@ -215,3 +221,28 @@ func TestVariant(t *testing.T) {
 		})
 	}
 }
+
+func TestAnnotations(t *testing.T) {
+	_, m := Main.Lookup([]rune("人"))
+	if len(m) == 0 {
+		t.Fatal("not found")
+	}
+	cls := m[0].Classifiers
+	if len(cls) != 2 || cls[0].Traditional != "個" ||
+		cls[0].Simplified != "个" || cls[1].Simplified != "位" {
+		t.Errorf("wrong classifiers: %v", cls)
+	}
+	_, m = Main.Lookup([]rune("箇"))
+	if len(m) != 1 {
+		t.Fatal("not found")
+	}
+	if m[0].Flags != cedict.Variant {
+		t.Errorf("wrong flags: %d", m[0].Flags)
+	}
+	refs := m[0].References
+	if len(refs) != 1 || refs[0].Kind != cedict.VariantOf ||
+		refs[0].Simplified != "个" || len(refs[0].Pinyin) != 1 ||
+		refs[0].Pinyin[0].Pinyin != pinyin.New(pinyin.GE, pinyin.Falling) {
+		t.Errorf("wrong references: %v", refs)
+	}
+}
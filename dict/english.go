@@ -0,0 +1,149 @@
+package dict
+
+import (
+	"sort"
+
+	"github.com/hgoes/hanyu/internal/gloss"
+)
+
+// scores used to rank the results of an english search
+const (
+	scoreExact   = 1000
+	scorePartial = 100
+	scoreHSK     = 20
+)
+
+// SearchEnglish finds all meanings with an english gloss containing
+// every word of the query. Meanings with a gloss that matches the
+// query exactly are ranked first, words from the HSK get ranked
+// higher, the lower their level. Other than for [Dict.Lookup], the
+// Simplified and Traditional fields of the results are always set.
+func (d *Dict) SearchEnglish(query string) []Meaning {
+	if len(d.english) == 0 {
+		return nil
+	}
+	normQuery := gloss.Normalize(query)
+	words := gloss.Words(normQuery)
+	if len(words) == 0 {
+		return nil
+	}
+	var candidates []uint32
+	for i, w := range words {
+		postings := postings(d.english, w)
+		if i == 0 {
+			candidates = postings
+		} else {
+			candidates = intersect(candidates, postings)
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+	}
+	type result struct {
+		meaning Meaning
+		score   int
+	}
+	results := make([]result, len(candidates))
+	for i, idx := range candidates {
+		m := d.meaningAt(idx)
+		results[i] = result{
+			meaning: m,
+			score:   englishScore(&m, normQuery, words),
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+	meanings := make([]Meaning, len(results))
+	for i := range results {
+		meanings[i] = results[i].meaning
+	}
+	return meanings
+}
+
+func englishScore(m *Meaning, normQuery string, words []string) int {
+	score := 0
+	for _, mean := range m.Meanings {
+		norm := gloss.Normalize(mean)
+		if norm == normQuery {
+			score = scoreExact
+			break
+		}
+		glossWords := gloss.Words(norm)
+		if !containsAll(glossWords, words) {
+			continue
+		}
+		// prefer glosses with fewer additional words
+		partial := scorePartial - (len(glossWords) - len(words))
+		if partial > score {
+			score = partial
+		}
+	}
+	if m.HSKLevel != 0 {
+		score += scoreHSK * (7 - int(m.HSKLevel))
+	}
+	return score
+}
+
+func containsAll(haystack, needles []string) bool {
+	for _, n := range needles {
+		found := false
+		for _, h := range haystack {
+			if h == n {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// postings looks up the sorted meaning indices of a word in an index
+// from words to meanings, i.e. the english or the classifier index.
+func postings(index []byte, word string) []uint32 {
+	if len(index) == 0 {
+		return nil
+	}
+	count := int(uint24(index))
+	data := index[3+3*count:]
+	entry := func(i int) []byte {
+		return data[uint24(index[3+3*i:]):]
+	}
+	idx := sort.Search(count, func(i int) bool {
+		e := entry(i)
+		return string(e[1:1+int(e[0])]) >= word
+	})
+	if idx >= count {
+		return nil
+	}
+	e := entry(idx)
+	if string(e[1:1+int(e[0])]) != word {
+		return nil
+	}
+	e = e[1+int(e[0]):]
+	postings := make([]uint32, uint24(e))
+	for i := range postings {
+		postings[i] = uint24(e[3+3*i:])
+	}
+	return postings
+}
+
+func intersect(xs, ys []uint32) []uint32 {
+	var result []uint32
+	for len(xs) > 0 && len(ys) > 0 {
+		switch {
+		case xs[0] < ys[0]:
+			xs = xs[1:]
+		case xs[0] > ys[0]:
+			ys = ys[1:]
+		default:
+			result = append(result, xs[0])
+			xs = xs[1:]
+			ys = ys[1:]
+		}
+	}
+	return result
+}
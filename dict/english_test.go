@@ -0,0 +1,43 @@
+package dict
+
+import "testing"
+
+func TestSearchEnglish(t *testing.T) {
+	tests := []struct {
+		Query string
+		First string
+	}{
+		{
+			Query: "good",
+			First: "好",
+		},
+		{
+			Query: "to steal",
+			First: "偷",
+		},
+		{
+			Query: "Computer",
+			First: "电脑",
+		},
+		{
+			Query: "xyzzy",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			res := Main.SearchEnglish(test.Query)
+			if test.First == "" {
+				if len(res) != 0 {
+					t.Fatalf("expected no result, got %d", len(res))
+				}
+				return
+			}
+			if len(res) == 0 {
+				t.Fatal("no result")
+			}
+			if res[0].Simplified != test.First {
+				t.Errorf("wrong first result: %q", res[0].Simplified)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package dict
+
+// Layer is a dictionary that is part of a [Layered] dictionary.
+type Layer struct {
+	Dict Dictionary
+	// Override hides the meanings of every word found in this
+	// layer from all lower layers.
+	Override bool
+	// Hide lists words whose meanings are hidden from all lower
+	// layers, regardless of whether they are found in this layer.
+	Hide []string
+}
+
+type layer struct {
+	dict     Dictionary
+	override bool
+	hide     map[string]bool
+	// length of the longest hidden word
+	maxHide int
+}
+
+// Layered combines several dictionaries, e.g. a glossary on top of
+// [Main]. The meanings of a word in all layers are merged, with the
+// meanings of higher layers coming first.
+type Layered struct {
+	layers []layer
+}
+
+// NewLayered creates a dictionary from layers, ordered by priority,
+// so that the first layer has the highest priority.
+func NewLayered(layers ...Layer) *Layered {
+	result := &Layered{
+		layers: make([]layer, len(layers)),
+	}
+	for i, l := range layers {
+		result.layers[i] = layer{
+			dict:     l.Dict,
+			override: l.Override,
+		}
+		if len(l.Hide) == 0 {
+			continue
+		}
+		result.layers[i].hide = make(map[string]bool, len(l.Hide))
+		for _, w := range l.Hide {
+			result.layers[i].hide[w] = true
+			if n := len([]rune(w)); n > result.layers[i].maxHide {
+				result.layers[i].maxHide = n
+			}
+		}
+	}
+	return result
+}
+
+// Lookup looks up the longest word found in any layer. Returns the
+// number of characters consumed and the merged meanings. Other than
+// [Dict.Lookup], no words are split up to find a better match.
+func (l *Layered) Lookup(str []rune) (int, []Meaning) {
+	var lastLen int
+	var lastMeanings []Meaning
+	l.Prefixes(str, func(n int, m []Meaning) bool {
+		lastLen = n
+		lastMeanings = m
+		return true
+	})
+	return lastLen, lastMeanings
+}
+
+// Prefixes calls f for every word in any layer that is a prefix of
+// str, shortest first, until f returns false. The meanings of all
+// layers are merged.
+func (l *Layered) Prefixes(str []rune, f func(n int, m []Meaning) bool) {
+	// merged meanings by word length
+	merged := make(map[int][]Meaning)
+	hidden := make(map[int]bool)
+	maxLen := 0
+	for _, layer := range l.layers {
+		var found []int
+		layer.dict.Prefixes(str, func(n int, m []Meaning) bool {
+			if hidden[n] {
+				return true
+			}
+			merged[n] = append(merged[n], m...)
+			found = append(found, n)
+			if n > maxLen {
+				maxLen = n
+			}
+			return true
+		})
+		if layer.override {
+			for _, n := range found {
+				hidden[n] = true
+			}
+		}
+		for n := 1; n <= layer.maxHide && n <= len(str); n++ {
+			if layer.hide[string(str[:n])] {
+				hidden[n] = true
+			}
+		}
+	}
+	for n := 1; n <= maxLen; n++ {
+		m, ok := merged[n]
+		if !ok {
+			continue
+		}
+		if !f(n, m) {
+			return
+		}
+	}
+}
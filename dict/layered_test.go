@@ -0,0 +1,116 @@
+package dict
+
+import (
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+func TestLayered(t *testing.T) {
+	glossary, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "漢語庫",
+			Simplified:  "汉语库",
+			Meaning:     []string{"hanyu library"},
+		},
+		{
+			Traditional: "卡",
+			Simplified:  "卡",
+			Meaning:     []string{"memory card"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	override, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "漢語",
+			Simplified:  "汉语",
+			Meaning:     []string{"mandarin"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewLayered(
+		Layer{
+			Dict:     override,
+			Override: true,
+			Hide:     []string{"中国"},
+		},
+		Layer{
+			Dict: glossary,
+		},
+		Layer{
+			Dict: &Main,
+		},
+	)
+	_, mainCard := Main.Lookup([]rune("卡"))
+	_, mainZhong := Main.Lookup([]rune("中"))
+	tests := []struct {
+		Input  string
+		Length int
+		First  string
+		Count  int
+	}{
+		{
+			Input:  "汉语库",
+			Length: 3,
+			First:  "hanyu library",
+			Count:  1,
+		},
+		{
+			Input:  "汉语",
+			Length: 2,
+			First:  "mandarin",
+			Count:  1,
+		},
+		{
+			Input:  "中国",
+			Length: 1,
+			First:  "(bound form) China; Chinese",
+			Count:  len(mainZhong),
+		},
+		{
+			Input:  "卡",
+			Length: 1,
+			First:  "memory card",
+			Count:  1 + len(mainCard),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			l, m := d.Lookup([]rune(test.Input))
+			if l != test.Length {
+				t.Fatal("wrong length:", l)
+			}
+			if len(m) != test.Count {
+				t.Fatal("wrong number of meanings:", len(m))
+			}
+			if actual := m[0].Meanings[0]; actual != test.First {
+				t.Errorf("wrong meaning: %q", actual)
+			}
+		})
+	}
+}
+
+func TestLayeredSegment(t *testing.T) {
+	glossary, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "生命科學家",
+			Simplified:  "生命科学家",
+			Meaning:     []string{"life scientist"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewLayered(Layer{Dict: glossary}, Layer{Dict: &Main})
+	toks := d.SegmentBest("他是生命科学家")
+	if len(toks) != 3 || toks[2].Text != "生命科学家" {
+		t.Fatalf("wrong segmentation: %+v", toks)
+	}
+	if toks[2].Meanings[0].Meanings[0] != "life scientist" {
+		t.Errorf("wrong meaning: %+v", toks[2].Meanings)
+	}
+}
@@ -0,0 +1,98 @@
+package dict
+
+import (
+	"io"
+	"os"
+
+	"github.com/hgoes/hanyu/internal/builder"
+)
+
+var (
+	// ErrNotDict is returned when loading data that is not an
+	// encoded dictionary.
+	ErrNotDict = builder.ErrNotDict
+	// ErrVersion is returned when loading a dictionary that was
+	// encoded with an unsupported version of the binary format.
+	ErrVersion = builder.ErrVersion
+)
+
+// fromBytes creates a dictionary from its binary encoding, without
+// copying the data.
+func fromBytes(data []byte) (*Dict, error) {
+	bin, err := builder.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return fromBinary(bin), nil
+}
+
+// fromBinary creates a dictionary from its sections.
+func fromBinary(bin *builder.Binary) *Dict {
+	return &Dict{
+		bin:        bin.Dict,
+		english:    bin.English,
+		pinyin:     bin.Pinyin,
+		classifier: bin.Classifier,
+	}
+}
+
+// Load reads a dictionary written by [Dict.WriteTo] into memory. The
+// magic and version of the binary format are checked before anything
+// else is read, afterwards the dictionary is checked by
+// [Dict.Verify].
+func Load(r io.ReaderAt) (*Dict, error) {
+	hdr := make([]byte, builder.HeaderSize)
+	if n, err := r.ReadAt(hdr, 0); n < len(hdr) {
+		if err == io.EOF {
+			return nil, ErrNotDict
+		}
+		return nil, err
+	}
+	sizes, err := builder.ParseHeader(hdr)
+	if err != nil {
+		return nil, err
+	}
+	total := int64(builder.HeaderSize)
+	for _, sz := range sizes {
+		total += sz
+	}
+	// read incrementally, so that bogus sizes don't cause huge
+	// allocations
+	data, err := io.ReadAll(io.NewSectionReader(r, 0, total))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != total {
+		return nil, io.ErrUnexpectedEOF
+	}
+	d, err := fromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Verify(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Open loads a dictionary from a file, see [Load].
+func Open(path string) (*Dict, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// WriteTo writes the binary encoding of the dictionary, which can be
+// read by [Load].
+func (d *Dict) WriteTo(w io.Writer) (int64, error) {
+	bin := builder.Binary{
+		Dict:       d.bin,
+		English:    d.english,
+		Pinyin:     d.pinyin,
+		Classifier: d.classifier,
+	}
+	return bin.WriteTo(w)
+}
@@ -0,0 +1,88 @@
+package dict
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+func TestLoad(t *testing.T) {
+	d, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "卡",
+			Simplified:  "卡",
+			Meaning:     []string{"card"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "dict.bin")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.WriteTo(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, m := loaded.Lookup([]rune("卡"))
+	if l != 1 || len(m) != 1 || m[0].Meanings[0] != "card" {
+		t.Errorf("wrong lookup result: %d %+v", l, m)
+	}
+	if res := loaded.SearchEnglish("card"); len(res) != 1 {
+		t.Errorf("wrong number of english results: %d", len(res))
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Main.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	tests := []struct {
+		Name string
+		Data []byte
+		Err  error
+	}{
+		{
+			Name: "empty",
+			Err:  ErrNotDict,
+		},
+		{
+			Name: "magic",
+			Data: append([]byte("NOTADICT"), data[8:]...),
+			Err:  ErrNotDict,
+		},
+		{
+			Name: "version",
+			Data: append(append(append([]byte{}, data[:8]...), 0xFF, 0xFF), data[10:]...),
+			Err:  ErrVersion,
+		},
+		{
+			Name: "truncated",
+			Data: data[:len(data)-1],
+			Err:  io.ErrUnexpectedEOF,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := Load(bytes.NewReader(test.Data))
+			if !errors.Is(err, test.Err) {
+				t.Errorf("wrong error: %v", err)
+			}
+		})
+	}
+}
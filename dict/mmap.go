@@ -0,0 +1,10 @@
+package dict
+
+// Mapped is a dictionary backed by a read-only memory-mapped file, so
+// that processes using the same file share its memory. It has to be
+// closed once it is no longer used, after which it must not be used
+// anymore.
+type Mapped struct {
+	Dict
+	data []byte
+}
@@ -0,0 +1,58 @@
+package dict
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/hgoes/hanyu/internal/builder"
+)
+
+// Mmap memory-maps a dictionary file written by [Dict.WriteTo]. The
+// dictionary is checked by [Dict.Verify].
+func Mmap(path string) (*Mapped, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size < int64(builder.HeaderSize) {
+		return nil, ErrNotDict
+	}
+	if int64(int(size)) != size {
+		return nil, syscall.EFBIG
+	}
+	data, err := syscall.Mmap(
+		int(f.Fd()), 0, int(size),
+		syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	d, err := fromBytes(data)
+	if err == nil {
+		err = d.Verify()
+	}
+	if err != nil {
+		syscall.Munmap(data)
+		return nil, err
+	}
+	return &Mapped{
+		Dict: *d,
+		data: data,
+	}, nil
+}
+
+// Close unmaps the dictionary file.
+func (m *Mapped) Close() error {
+	if m.data == nil {
+		return nil
+	}
+	err := syscall.Munmap(m.data)
+	m.data = nil
+	m.Dict = Dict{}
+	return err
+}
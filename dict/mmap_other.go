@@ -0,0 +1,22 @@
+//go:build !linux
+
+package dict
+
+// Mmap loads a dictionary file written by [Dict.WriteTo]. Memory
+// mapping is only supported on linux, on other systems the file is
+// read into memory.
+func Mmap(path string) (*Mapped, error) {
+	d, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Mapped{
+		Dict: *d,
+	}, nil
+}
+
+// Close releases the dictionary.
+func (m *Mapped) Close() error {
+	m.Dict = Dict{}
+	return nil
+}
@@ -0,0 +1,43 @@
+package dict
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMmap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dict.bin")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Main.WriteTo(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	m, err := Mmap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, mean := m.Lookup([]rune("不可胜数"))
+	if l != 4 || mean[0].Meanings[0] != "countless" {
+		t.Errorf("wrong lookup result: %d %+v", l, mean)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMmapInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dict.bin")
+	if err := os.WriteFile(path, []byte("no dictionary at all"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Mmap(path); !errors.Is(err, ErrNotDict) {
+		t.Errorf("wrong error: %v", err)
+	}
+}
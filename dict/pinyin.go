@@ -0,0 +1,168 @@
+package dict
+
+import (
+	"encoding/binary"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+// PinyinMatch controls how [Dict.SearchPinyin] matches the syllables
+// of a query.
+type PinyinMatch byte
+
+const (
+	// IgnoreTones only compares the sounds of the syllables, not
+	// their tones.
+	IgnoreTones PinyinMatch = 1 << iota
+	// PinyinPrefix also finds words that start with the syllables
+	// of the query. The last syllable of the query may be
+	// incomplete and matches the start of a syllable of any tone,
+	// so "zhong1g" and "zhong1gu" find 中国.
+	PinyinPrefix
+)
+
+// SearchPinyin finds all meanings with a pronunciation that matches
+// the query. The query is parsed using [pinyin.ParseMany], so tones
+// can be given by numbers or diacritics. Without [IgnoreTones], a
+// syllable without tone in the query only matches neutral tones.
+// Shorter words are ranked first, then words from the HSK. Other
+// than for [Dict.Lookup], the Simplified and Traditional fields of
+// the results are always set.
+func (d *Dict) SearchPinyin(query string, match PinyinMatch) []Meaning {
+	if len(d.pinyin) == 0 {
+		return nil
+	}
+	pins, rest := pinyin.ParseMany([]rune(query))
+	partial := partialSyllable(rest)
+	if partial != "" && match&PinyinPrefix == 0 {
+		return nil
+	}
+	if len(pins) == 0 && partial == "" {
+		return nil
+	}
+	if match&PinyinPrefix != 0 && partial == "" {
+		// a complete last syllable may still be the start of a
+		// longer one, like gu of guo
+		sound, _ := pins[len(pins)-1].Decode()
+		partial = sound.String()
+		pins = pins[:len(pins)-1]
+	}
+	count := int(uint24(d.pinyin))
+	start := sort.Search(count, func(i int) bool {
+		return compareSounds(d.pinyinEntry(i), pins) >= 0
+	})
+	type result struct {
+		meaning   Meaning
+		syllables int
+	}
+	var results []result
+	for i := start; i < count; i++ {
+		entry := d.pinyinEntry(i)
+		if compareSounds(entry, pins) != 0 {
+			break
+		}
+		syllables := int(entry[0])
+		if syllables != len(pins) && match&PinyinPrefix == 0 {
+			continue
+		}
+		if match&IgnoreTones == 0 && !sameTones(entry, pins) {
+			continue
+		}
+		if partial != "" {
+			if syllables == len(pins) {
+				continue
+			}
+			sound, _ := entryPinyin(entry, len(pins)).Decode()
+			if !strings.HasPrefix(sound.String(), partial) {
+				continue
+			}
+		}
+		idx := uint24(entry[1+2*syllables:])
+		results = append(results, result{
+			meaning:   d.meaningAt(idx),
+			syllables: syllables,
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].syllables != results[j].syllables {
+			return results[i].syllables < results[j].syllables
+		}
+		return hskRank(results[i].meaning.HSKLevel) <
+			hskRank(results[j].meaning.HSKLevel)
+	})
+	meanings := make([]Meaning, len(results))
+	for i := range results {
+		meanings[i] = results[i].meaning
+	}
+	return meanings
+}
+
+// pinyinEntry returns the i-th entry of the pinyin index.
+func (d *Dict) pinyinEntry(i int) []byte {
+	count := int(uint24(d.pinyin))
+	return d.pinyin[3+3*count+int(uint24(d.pinyin[3+3*i:])):]
+}
+
+func entryPinyin(entry []byte, i int) pinyin.Pinyin {
+	return pinyin.Pinyin(binary.BigEndian.Uint16(entry[1+2*i:]))
+}
+
+// compareSounds compares the sounds of an index entry with the
+// sounds of a query. Returns 0 if the entry starts with the query.
+func compareSounds(entry []byte, query []pinyin.Pinyin) int {
+	syllables := int(entry[0])
+	for i, q := range query {
+		if i >= syllables {
+			return -1
+		}
+		se, _ := entryPinyin(entry, i).Decode()
+		sq, _ := q.Decode()
+		if se != sq {
+			if se < sq {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func sameTones(entry []byte, query []pinyin.Pinyin) bool {
+	for i, q := range query {
+		if entryPinyin(entry, i) != q {
+			return false
+		}
+	}
+	return true
+}
+
+// partialSyllable normalizes the unparsed rest of a query.
+func partialSyllable(rest []rune) string {
+	var buf strings.Builder
+	for i := 0; i < len(rest); i++ {
+		r := unicode.ToLower(rest[i])
+		switch {
+		case r == ' ' || r == '\'':
+		case r == 'v':
+			buf.WriteRune('ü')
+		case r == 'u' && i+1 < len(rest) && rest[i+1] == ':':
+			buf.WriteRune('ü')
+			i++
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// hskRank orders HSK levels, such that words that are not in the HSK
+// come last.
+func hskRank(level byte) int {
+	if level == 0 {
+		return 7
+	}
+	return int(level)
+}
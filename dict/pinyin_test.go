@@ -0,0 +1,84 @@
+package dict
+
+import "testing"
+
+func TestSearchPinyin(t *testing.T) {
+	tests := []struct {
+		Query string
+		Match PinyinMatch
+		First string
+	}{
+		{
+			Query: "zhong1guo2",
+			First: "中国",
+		},
+		{
+			Query: "zhōngguó",
+			First: "中国",
+		},
+		{
+			Query: "zhongguo",
+		},
+		{
+			Query: "zhongguo",
+			Match: IgnoreTones,
+			First: "中国",
+		},
+		{
+			Query: "zhong1g",
+			Match: PinyinPrefix,
+			First: "中国",
+		},
+		{
+			Query: "zhong1g",
+		},
+		{
+			Query: "zhongg",
+			Match: PinyinPrefix,
+		},
+		{
+			Query: "zhongg",
+			Match: IgnoreTones | PinyinPrefix,
+			First: "中国",
+		},
+		{
+			Query: "zhong1gu",
+			Match: PinyinPrefix,
+			First: "中国",
+		},
+		{
+			Query: "zhong1gu",
+			Match: IgnoreTones | PinyinPrefix,
+			First: "中国",
+		},
+		{
+			Query: "zhong1guo",
+			Match: PinyinPrefix,
+			First: "中国",
+		},
+		{
+			Query: "zhong1gu",
+		},
+		{
+			Query: "xyz",
+			Match: IgnoreTones | PinyinPrefix,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			res := Main.SearchPinyin(test.Query, test.Match)
+			if test.First == "" {
+				if len(res) != 0 {
+					t.Fatalf("expected no result, got %d", len(res))
+				}
+				return
+			}
+			if len(res) == 0 {
+				t.Fatal("no result")
+			}
+			if res[0].Simplified != test.First {
+				t.Errorf("wrong first result: %q", res[0].Simplified)
+			}
+		})
+	}
+}
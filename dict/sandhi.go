@@ -0,0 +1,104 @@
+package dict
+
+import (
+	"github.com/hgoes/hanyu/cedict"
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+// Pronounce returns the pronunciation of segmented text as spoken,
+// with tone sandhi applied (see [pinyin.Sandhi]). The result has an
+// entry for every token: the syllables of a matched word, or nil for
+// other tokens. Words use their most common reading (see [reading]).
+// Consecutive words form a phrase, other tokens (punctuation, spaces,
+// unknown characters) separate phrases.
+func (d *Dict) Pronounce(tokens []Token) [][]pinyin.Syllable {
+	result := make([][]pinyin.Syllable, len(tokens))
+	start := 0
+	for i, tok := range tokens {
+		result[i] = d.syllables(tok)
+		if result[i] == nil {
+			pinyin.Sandhi(result[start:i])
+			start = i + 1
+		}
+	}
+	pinyin.Sandhi(result[start:])
+	return result
+}
+
+// syllables returns the syllables of a token, or nil if it has no
+// pronunciation.
+func (d *Dict) syllables(tok Token) []pinyin.Syllable {
+	if tok.Kind != Word {
+		return nil
+	}
+	chars := []rune(tok.Text)
+	ps := reading(tok.Meanings, len(chars))
+	if ps == nil {
+		return nil
+	}
+	classifier := len(chars) == 1 && d.IsClassifier(tok.Text)
+	result := make([]pinyin.Syllable, len(chars))
+	for i, c := range chars {
+		result[i] = pinyin.Syllable{
+			Char:       c,
+			Pinyin:     ps[i].Pinyin,
+			Surface:    ps[i].Pinyin,
+			Classifier: classifier,
+		}
+	}
+	return result
+}
+
+// reading chooses the pinyin of a word with n characters among its
+// meanings. As the dictionary has no frequencies of readings, the most
+// common one is estimated as the reading with the most glosses (个 is
+// gè rather than gě). Variants, surnames, abbreviations and proper
+// nouns only count if there is no other meaning. If readings have as
+// many glosses, the first one wins. Returns nil if no meaning has a
+// syllable for every character.
+func reading(meanings []Meaning, n int) []cedict.Pinyin {
+	for _, special := range []bool{false, true} {
+		var best []cedict.Pinyin
+		bestGlosses := 0
+		glosses := make(map[string]int)
+		for _, m := range meanings {
+			if !pronounceable(m.Pinyin, n) ||
+				(!special && (m.Flags != 0 || m.Pinyin[0].Capitalized)) {
+				continue
+			}
+			key := readingKey(m.Pinyin)
+			glosses[key] += len(m.Meanings)
+			if best == nil || glosses[key] > bestGlosses {
+				best = m.Pinyin
+				bestGlosses = glosses[key]
+			}
+		}
+		if best != nil {
+			return best
+		}
+	}
+	return nil
+}
+
+// readingKey identifies a reading regardless of capitalization.
+func readingKey(ps []cedict.Pinyin) string {
+	key := make([]pinyin.Pinyin, len(ps))
+	for i, p := range ps {
+		key[i] = p.Pinyin
+	}
+	return pinyin.RenderMany(key)
+}
+
+// pronounceable tells whether the pinyin of a meaning has a syllable
+// for each of n characters.
+func pronounceable(ps []cedict.Pinyin, n int) bool {
+	if len(ps) != n || n == 0 {
+		return false
+	}
+	for _, p := range ps {
+		if p.Literal != "" {
+			return false
+		}
+	}
+	return true
+}
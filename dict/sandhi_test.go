@@ -0,0 +1,106 @@
+package dict
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+func TestPronounce(t *testing.T) {
+	tests := []struct {
+		Input      string
+		Underlying string
+		Surface    string
+	}{
+		{
+			Input:      "我很好。",
+			Underlying: "wǒ|hěn|hǎo|",
+			Surface:    "wǒ|hén|hǎo|",
+		},
+		{
+			Input:      "一个人",
+			Underlying: "yīgèrén",
+			Surface:    "yígèrén",
+		},
+		{
+			// reduplication within a word keeps the dictionary tones
+			Input:      "看一看",
+			Underlying: "kànyīkàn",
+			Surface:    "kànyīkàn",
+		},
+		{
+			// numerals are no reduplicated verbs
+			Input:      "三一三",
+			Underlying: "sān|yī|sān",
+			Surface:    "sān|yī|sān",
+		},
+		{
+			// neither are classifiers
+			Input:      "一个一个",
+			Underlying: "yī|gè|yī|gè",
+			Surface:    "yí|gè|yí|gè",
+		},
+		{
+			// punctuation separates phrases
+			Input:      "好，好",
+			Underlying: "hǎo||hǎo",
+			Surface:    "hǎo||hǎo",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			toks := Main.SegmentBest(test.Input)
+			words := Main.Pronounce(toks)
+			if len(words) != len(toks) {
+				t.Fatalf("expected %d words, got %d", len(toks), len(words))
+			}
+			var underlying, surface []string
+			for _, word := range words {
+				underlying = append(underlying,
+					pinyin.RenderMany(pinyin.Underlying(word)))
+				surface = append(surface,
+					pinyin.RenderMany(pinyin.Surface(word)))
+			}
+			if str := strings.Join(underlying, "|"); str != test.Underlying {
+				t.Errorf("wrong underlying pinyin: %s", str)
+			}
+			if str := strings.Join(surface, "|"); str != test.Surface {
+				t.Errorf("wrong surface pinyin: %s", str)
+			}
+		})
+	}
+}
+
+func TestPronounceProperNoun(t *testing.T) {
+	d, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "華",
+			Simplified:  "华",
+			Pinyin: []cedict.Pinyin{{
+				Pinyin:      pinyin.New(pinyin.HUA, pinyin.Falling),
+				Capitalized: true,
+			}},
+			Meaning: []string{"Mount Hua", "Hua county", "Huashan"},
+		},
+		{
+			Traditional: "華",
+			Simplified:  "华",
+			Pinyin: []cedict.Pinyin{{
+				Pinyin: pinyin.New(pinyin.HUA, pinyin.Rising),
+			}},
+			Meaning: []string{"magnificent"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := d.Pronounce(d.SegmentBest("华"))
+	if len(words) != 1 {
+		t.Fatalf("expected 1 word, got %d", len(words))
+	}
+	if str := pinyin.RenderMany(pinyin.Underlying(words[0])); str != "huá" {
+		t.Errorf("wrong reading: %s", str)
+	}
+}
@@ -0,0 +1,284 @@
+package dict
+
+import (
+	"unicode"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+// TokenKind classifies the characters a [Token] consists of.
+type TokenKind byte
+
+const (
+	// Word is a run of chinese (or other non-latin) characters.
+	Word TokenKind = iota
+	// Latin is a run of latin letters.
+	Latin
+	// Digit is a run of decimal digits.
+	Digit
+	// Punctuation is a single punctuation mark or symbol.
+	Punctuation
+	// Space is a run of white space.
+	Space
+)
+
+// Token is a part of a segmented text. Start and End are the rune
+// offsets, ByteStart and ByteEnd the byte offsets of the token in the
+// segmented text. If the token is found in the dictionary, its
+// meanings are attached.
+type Token struct {
+	Kind      TokenKind
+	Text      string
+	Start     int
+	End       int
+	ByteStart int
+	ByteEnd   int
+	Meanings  []Meaning
+}
+
+// Matched returns whether the token was found in the dictionary.
+func (t *Token) Matched() bool {
+	return len(t.Meanings) != 0
+}
+
+func kindOf(r rune) TokenKind {
+	switch {
+	case unicode.IsSpace(r):
+		return Space
+	case unicode.IsDigit(r):
+		return Digit
+	case unicode.IsPunct(r) || unicode.IsSymbol(r):
+		return Punctuation
+	case unicode.Is(unicode.Latin, r):
+		return Latin
+	default:
+		return Word
+	}
+}
+
+// segmenter holds the state shared by the segmentation algorithms.
+type segmenter struct {
+	text    string
+	runes   []rune
+	offsets []int
+}
+
+func newSegmenter(text string) *segmenter {
+	runes := []rune(text)
+	// byte offsets of every rune, plus the end of the text
+	offsets := make([]int, 0, len(runes)+1)
+	for i := range text {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(text))
+	return &segmenter{
+		text:    text,
+		runes:   runes,
+		offsets: offsets,
+	}
+}
+
+func (s *segmenter) token(
+	kind TokenKind,
+	start, end int,
+	meanings []Meaning,
+) Token {
+	return Token{
+		Kind:      kind,
+		Text:      s.text[s.offsets[start]:s.offsets[end]],
+		Start:     start,
+		End:       end,
+		ByteStart: s.offsets[start],
+		ByteEnd:   s.offsets[end],
+		Meanings:  meanings,
+	}
+}
+
+// run returns the end of the run of characters starting at pos that
+// form a single token of the given kind, as well as the meanings of
+// the run if it is a word in the dictionary.
+func (s *segmenter) run(
+	d Dictionary,
+	kind TokenKind,
+	pos int,
+) (int, []Meaning) {
+	if kind == Punctuation {
+		return pos + 1, nil
+	}
+	end := pos + 1
+	for end < len(s.runes) && kindOf(s.runes[end]) == kind {
+		end++
+	}
+	if kind == Space {
+		return end, nil
+	}
+	l, m := d.Lookup(s.runes[pos:end])
+	if l != end-pos {
+		return end, nil
+	}
+	return end, m
+}
+
+// Segment splits a text into tokens, using greedy longest matching
+// (see [Dict.Lookup]) for words. Runs of latin letters or digits are
+// only matched if the whole run is a word in the dictionary. Every
+// character of the text is part of exactly one token.
+func (d *Dict) Segment(text string) []Token {
+	return segment(d, text)
+}
+
+// Segment splits a text into tokens like [Dict.Segment].
+func (l *Layered) Segment(text string) []Token {
+	return segment(l, text)
+}
+
+func segment(d Dictionary, text string) []Token {
+	s := newSegmenter(text)
+	var result []Token
+	for i := 0; i < len(s.runes); {
+		kind := kindOf(s.runes[i])
+		end := i + 1
+		var meanings []Meaning
+		if kind == Word {
+			var l int
+			l, meanings = d.Lookup(s.runes[i:])
+			if l > 0 {
+				end = i + l
+			}
+		} else {
+			end, meanings = s.run(d, kind, i)
+		}
+		result = append(result, s.token(kind, i, end, meanings))
+		i = end
+	}
+	return result
+}
+
+const (
+	// cost of every word, so that fewer words are preferred
+	costWord = 20
+	// cost of a character that is not found in the dictionary
+	costUnknown = 30
+	// additional cost of a word that is only a variant, a surname
+	// or a cross-reference
+	costRare = 5
+)
+
+// wordCost estimates how unlikely it is that the word with the given
+// meanings occurs in a text. Words from the HSK are considered to be
+// more common, the lower the HSK level the more common.
+func wordCost(meanings []Meaning) int {
+	if len(meanings) == 0 {
+		return costUnknown
+	}
+	var hsk byte
+	rare := true
+	for _, m := range meanings {
+		if m.HSKLevel != 0 && (hsk == 0 || m.HSKLevel < hsk) {
+			hsk = m.HSKLevel
+		}
+		if !isRareMeaning(&m) {
+			rare = false
+		}
+	}
+	cost := costWord
+	if hsk != 0 {
+		cost -= 7 - int(hsk)
+	}
+	if rare {
+		cost += costRare
+	}
+	return cost
+}
+
+// isRareMeaning returns whether a meaning is a variant or surname, or
+// refers to another word.
+func isRareMeaning(m *Meaning) bool {
+	if m.Flags&(cedict.Variant|cedict.Surname) != 0 {
+		return true
+	}
+	for _, ref := range m.References {
+		switch ref.Kind {
+		case cedict.See, cedict.UsedIn:
+			return true
+		}
+	}
+	return false
+}
+
+// SegmentBest splits a text into tokens like [Dict.Segment], but
+// instead of greedily taking the longest word, it considers every
+// possible segmentation and picks the one with the lowest total
+// cost. Every word costs the same, so fewer words are preferred, but
+// HSK words are cheaper, and words that are only variants,
+// cross-references or surnames are more expensive.
+func (d *Dict) SegmentBest(text string) []Token {
+	return segmentBest(d, text)
+}
+
+// SegmentBest splits a text into tokens like [Dict.SegmentBest].
+func (l *Layered) SegmentBest(text string) []Token {
+	return segmentBest(l, text)
+}
+
+func segmentBest(d Dictionary, text string) []Token {
+	s := newSegmenter(text)
+	n := len(s.runes)
+	// best[i] is the cheapest segmentation of the first i runes,
+	// represented by its cost and its last token
+	type step struct {
+		cost      int
+		reachable bool
+		token     Token
+	}
+	best := make([]step, n+1)
+	best[0].reachable = true
+	relax := func(kind TokenKind, start, end int, cost int, m []Meaning) {
+		cost += best[start].cost
+		if best[end].reachable && best[end].cost <= cost {
+			return
+		}
+		best[end] = step{
+			cost:      cost,
+			reachable: true,
+			token:     s.token(kind, start, end, m),
+		}
+	}
+	for i := 0; i < n; i++ {
+		if !best[i].reachable {
+			continue
+		}
+		kind := kindOf(s.runes[i])
+		if kind != Word {
+			end, m := s.run(d, kind, i)
+			cost := 0
+			if kind != Space && kind != Punctuation {
+				cost = wordCost(m)
+			}
+			relax(kind, i, end, cost, m)
+			continue
+		}
+		single := false
+		d.Prefixes(s.runes[i:], func(l int, m []Meaning) bool {
+			if l == 1 {
+				single = true
+			}
+			relax(Word, i, i+l, wordCost(m), m)
+			return true
+		})
+		if !single {
+			relax(Word, i, i+1, costUnknown, nil)
+		}
+	}
+	// backtrack the cheapest path
+	var count int
+	for i := n; i > 0; i = best[i].token.Start {
+		count++
+	}
+	result := make([]Token, count)
+	for i := n; i > 0; i = best[i].token.Start {
+		count--
+		result[count] = best[i].token
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+package dict
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSegment(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output string
+	}{
+		{
+			Input:  "我有时候喝咖啡。",
+			Output: "我|有时候|喝|咖啡|。",
+		},
+		{
+			Input:  "我用iPhone 15打电话",
+			Output: "我|用|iPhone|_|15|打电话",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			var parts []string
+			for _, tok := range Main.Segment(test.Input) {
+				if tok.Kind == Space {
+					parts = append(parts, "_")
+				} else {
+					parts = append(parts, tok.Text)
+				}
+			}
+			if actual := strings.Join(parts, "|"); actual != test.Output {
+				t.Errorf("wrong segmentation: %q", actual)
+			}
+		})
+	}
+}
+
+func TestSegmentTokens(t *testing.T) {
+	toks := Main.Segment("A 你好, 88")
+	expected := []Token{
+		{Kind: Latin, Text: "A", Start: 0, End: 1, ByteStart: 0, ByteEnd: 1},
+		{Kind: Space, Text: " ", Start: 1, End: 2, ByteStart: 1, ByteEnd: 2},
+		{Kind: Word, Text: "你好", Start: 2, End: 4, ByteStart: 2, ByteEnd: 8},
+		{Kind: Punctuation, Text: ",", Start: 4, End: 5, ByteStart: 8, ByteEnd: 9},
+		{Kind: Space, Text: " ", Start: 5, End: 6, ByteStart: 9, ByteEnd: 10},
+		{Kind: Digit, Text: "88", Start: 6, End: 8, ByteStart: 10, ByteEnd: 12},
+	}
+	if len(toks) != len(expected) {
+		t.Fatalf("wrong number of tokens: %d", len(toks))
+	}
+	for i, tok := range toks {
+		exp := expected[i]
+		if tok.Kind != exp.Kind || tok.Text != exp.Text ||
+			tok.Start != exp.Start || tok.End != exp.End ||
+			tok.ByteStart != exp.ByteStart || tok.ByteEnd != exp.ByteEnd {
+			t.Errorf("wrong token %d: %+v", i, tok)
+		}
+		if tok.Kind != Space && tok.Kind != Punctuation && !tok.Matched() {
+			t.Errorf("token %d not matched", i)
+		}
+	}
+}
+
+func TestSegmentBest(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output string
+	}{
+		{
+			Input:  "研究生命",
+			Output: "研究|生命",
+		},
+		{
+			Input:  "和服务",
+			Output: "和|服务",
+		},
+		{
+			Input:  "不复杂",
+			Output: "不|复杂",
+		},
+		{
+			Input:  "有时候",
+			Output: "有时候",
+		},
+		{
+			Input:  "结婚的和尚未结婚的",
+			Output: "结婚|的|和|尚未|结婚|的",
+		},
+		{
+			Input:  "我喜欢卡拉OK!",
+			Output: "我|喜欢|卡拉OK|!",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			var parts []string
+			for _, tok := range Main.SegmentBest(test.Input) {
+				parts = append(parts, tok.Text)
+			}
+			if actual := strings.Join(parts, "|"); actual != test.Output {
+				t.Errorf("wrong segmentation: %q", actual)
+			}
+		})
+	}
+}
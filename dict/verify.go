@@ -0,0 +1,406 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+// ErrCorrupt is returned for dictionaries with an invalid binary
+// encoding.
+var ErrCorrupt = errors.New("corrupt dictionary")
+
+// checker reads a section of a binary dictionary, returning errors
+// instead of panicking on out of bounds accesses.
+type checker struct {
+	section string
+	data    []byte
+}
+
+func (c *checker) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s: %s",
+		ErrCorrupt, c.section, fmt.Sprintf(format, args...))
+}
+
+func (c *checker) need(pos, n int) error {
+	if pos < 0 || n < 0 || pos > len(c.data) || n > len(c.data)-pos {
+		return c.errorf("%d bytes at offset %d out of bounds", n, pos)
+	}
+	return nil
+}
+
+func (c *checker) byte(pos int) (byte, error) {
+	if err := c.need(pos, 1); err != nil {
+		return 0, err
+	}
+	return c.data[pos], nil
+}
+
+func (c *checker) uint16(pos int) (int, error) {
+	if err := c.need(pos, 2); err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(c.data[pos:])), nil
+}
+
+func (c *checker) uint24(pos int) (int, error) {
+	if err := c.need(pos, 3); err != nil {
+		return 0, err
+	}
+	return int(uint24(c.data[pos:])), nil
+}
+
+func (c *checker) bytes(pos, n int) ([]byte, error) {
+	if err := c.need(pos, n); err != nil {
+		return nil, err
+	}
+	return c.data[pos : pos+n], nil
+}
+
+// Verify checks the whole binary encoding of the dictionary, so that
+// looking up words can't fail afterwards. Dictionaries read by
+// [Load], [Open] and [Mmap] are verified automatically.
+func (d *Dict) Verify() error {
+	c := checker{
+		section: "dictionary",
+		data:    d.bin,
+	}
+	runeCount, err := c.uint24(0)
+	if err != nil {
+		return err
+	}
+	meaningsOffset, err := c.uint24(3 + 3*runeCount)
+	if err != nil {
+		return err
+	}
+	root := 6 + 3*runeCount
+	table := root + meaningsOffset
+	if err := c.need(table, 0); err != nil {
+		return err
+	}
+	// the number of meanings is given by the offset of the first one
+	meaningCount := 0
+	if table < len(d.bin) {
+		first, err := c.uint24(table)
+		if err != nil {
+			return err
+		}
+		if first%3 != 0 {
+			return c.errorf("invalid meaning table size %d", first)
+		}
+		meaningCount = first / 3
+	}
+	// highest variant position of every meaning
+	variantPos := make([]int, meaningCount)
+	for i := range variantPos {
+		offset, err := c.uint24(table + 3*i)
+		if err != nil {
+			return err
+		}
+		variantPos[i], err = c.meaning(table+offset, runeCount)
+		if err != nil {
+			return fmt.Errorf("meaning %d: %w", i, err)
+		}
+	}
+	if _, err := c.node(root, 0, runeCount, variantPos); err != nil {
+		return err
+	}
+	if err := verifyWordIndex("english index", d.english, meaningCount); err != nil {
+		return err
+	}
+	if err := verifyWordIndex("classifier index", d.classifier, meaningCount); err != nil {
+		return err
+	}
+	return verifyPinyin(d.pinyin, meaningCount)
+}
+
+// node checks a node of the trie at the given depth and all its
+// successors. Returns the end of the node's encoding.
+func (c *checker) node(
+	pos, depth, runeCount int,
+	variantPos []int,
+) (int, error) {
+	if depth > 0xFF {
+		return 0, c.errorf("trie too deep")
+	}
+	l, err := c.uint16(pos)
+	if err != nil {
+		return 0, err
+	}
+	end := pos + 2 + l*5
+	if err := c.need(pos+2, l*5); err != nil {
+		return 0, err
+	}
+	lastRune := rune(-1)
+	for i := 0; i < l; i++ {
+		entry := pos + 2 + i*5
+		runeIdx, _ := c.uint16(entry)
+		if runeIdx >= runeCount {
+			return 0, c.errorf("rune index %d out of range", runeIdx)
+		}
+		r := decodeRune(c.data, uint16(runeIdx))
+		if r <= lastRune {
+			return 0, c.errorf("successors of node at %d not sorted", pos)
+		}
+		lastRune = r
+		// every successor is stored directly after the previous
+		// one, which rules out cycles and shared successors
+		child, _ := c.uint24(entry + 2)
+		if child != end {
+			return 0, c.errorf("successor of node at %d misplaced", pos)
+		}
+		count, err := c.byte(child)
+		if err != nil {
+			return 0, err
+		}
+		if err := c.need(child+1, int(count)*3); err != nil {
+			return 0, err
+		}
+		for j := 0; j < int(count); j++ {
+			idx, _ := c.uint24(child + 1 + j*3)
+			if idx >= len(variantPos) {
+				return 0, c.errorf("meaning index %d out of range", idx)
+			}
+			if variantPos[idx] > depth+1 {
+				return 0, c.errorf("variant of meaning %d beyond word", idx)
+			}
+		}
+		end, err = c.node(child+1+int(count)*3, depth+1, runeCount, variantPos)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return end, nil
+}
+
+// meaning checks the encoding of a meaning. Returns the highest
+// position of a variant character.
+func (c *checker) meaning(pos, runeCount int) (int, error) {
+	// skip the HSK level
+	pos++
+	pos, err := c.pinyins(pos)
+	if err != nil {
+		return 0, err
+	}
+	meansSize, err := c.byte(pos)
+	if err != nil {
+		return 0, err
+	}
+	pos++
+	for j := 0; j < int(meansSize); j++ {
+		l, err := c.uint16(pos)
+		if err != nil {
+			return 0, err
+		}
+		pos += 2 + l
+	}
+	varSize, err := c.byte(pos)
+	if err != nil {
+		return 0, err
+	}
+	pos++
+	variants, err := c.bytes(pos, int(varSize)*5)
+	if err != nil {
+		return 0, err
+	}
+	pos += len(variants)
+	maxPos := 0
+	for j := 0; j < len(variants); j += 5 {
+		if int(variants[j])+1 > maxPos {
+			maxPos = int(variants[j]) + 1
+		}
+		if int(binary.BigEndian.Uint16(variants[j+1:])) >= runeCount ||
+			int(binary.BigEndian.Uint16(variants[j+3:])) >= runeCount {
+			return 0, c.errorf("variant rune index out of range")
+		}
+	}
+	headLen, err := c.byte(pos)
+	if err != nil {
+		return 0, err
+	}
+	head, err := c.bytes(pos+1, int(headLen))
+	if err != nil {
+		return 0, err
+	}
+	if len(bytes.Runes(head)) < maxPos {
+		return 0, c.errorf("variant beyond headword")
+	}
+	pos += 1 + len(head)
+	// skip the flags
+	pos++
+	clSize, err := c.byte(pos)
+	if err != nil {
+		return 0, err
+	}
+	pos++
+	for j := 0; j < int(clSize); j++ {
+		if pos, err = c.word(pos); err != nil {
+			return 0, err
+		}
+	}
+	refSize, err := c.byte(pos)
+	if err != nil {
+		return 0, err
+	}
+	pos++
+	for j := 0; j < int(refSize); j++ {
+		// skip the kind
+		if pos, err = c.word(pos + 1); err != nil {
+			return 0, err
+		}
+	}
+	return maxPos, nil
+}
+
+// pinyins checks a list of pinyins. Returns the position after it.
+func (c *checker) pinyins(pos int) (int, error) {
+	pinSz, err := c.byte(pos)
+	if err != nil {
+		return 0, err
+	}
+	pos++
+	for j := 0; j < int(pinSz); j++ {
+		b, err := c.byte(pos)
+		if err != nil {
+			return 0, err
+		}
+		if b > 127 {
+			pos += 2
+		} else {
+			pos += 1 + int(b)
+		}
+	}
+	return pos, c.need(pos, 0)
+}
+
+// word checks a word referenced by a meaning. Returns the position
+// after it.
+func (c *checker) word(pos int) (int, error) {
+	for i := 0; i < 2; i++ {
+		l, err := c.byte(pos)
+		if err != nil {
+			return 0, err
+		}
+		pos += 1 + int(l)
+	}
+	return c.pinyins(pos)
+}
+
+// index checks the common structure of the english, classifier and
+// pinyin indices and calls f for every entry.
+func (c *checker) index(f func(pos int) error) error {
+	if len(c.data) == 0 {
+		return nil
+	}
+	count, err := c.uint24(0)
+	if err != nil {
+		return err
+	}
+	if err := c.need(3, count*3); err != nil {
+		return err
+	}
+	data := 3 + 3*count
+	for i := 0; i < count; i++ {
+		offset, _ := c.uint24(3 + 3*i)
+		if err := f(data + offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyWordIndex checks an index from words to meanings, i.e. the
+// english or the classifier index.
+func verifyWordIndex(section string, index []byte, meaningCount int) error {
+	c := checker{
+		section: section,
+		data:    index,
+	}
+	var lastWord []byte
+	first := true
+	return c.index(func(pos int) error {
+		l, err := c.byte(pos)
+		if err != nil {
+			return err
+		}
+		word, err := c.bytes(pos+1, int(l))
+		if err != nil {
+			return err
+		}
+		if !first && bytes.Compare(lastWord, word) >= 0 {
+			return c.errorf("words not sorted")
+		}
+		first = false
+		lastWord = word
+		pos += 1 + int(l)
+		count, err := c.uint24(pos)
+		if err != nil {
+			return err
+		}
+		if err := c.need(pos+3, count*3); err != nil {
+			return err
+		}
+		last := -1
+		for i := 0; i < count; i++ {
+			idx, _ := c.uint24(pos + 3 + i*3)
+			if idx <= last || idx >= meaningCount {
+				return c.errorf("invalid meaning index %d", idx)
+			}
+			last = idx
+		}
+		return nil
+	})
+}
+
+func verifyPinyin(index []byte, meaningCount int) error {
+	c := checker{
+		section: "pinyin index",
+		data:    index,
+	}
+	var last []pinyin.Pinyin
+	return c.index(func(pos int) error {
+		l, err := c.byte(pos)
+		if err != nil {
+			return err
+		}
+		if l == 0 {
+			return c.errorf("entry without syllables")
+		}
+		raw, err := c.bytes(pos+1, int(l)*2)
+		if err != nil {
+			return err
+		}
+		pins := make([]pinyin.Pinyin, l)
+		for i := range pins {
+			pins[i] = pinyin.Pinyin(binary.BigEndian.Uint16(raw[i*2:]))
+		}
+		if last != nil && !soundsOrdered(last, pins) {
+			return c.errorf("entries not sorted")
+		}
+		last = pins
+		idx, err := c.uint24(pos + 1 + int(l)*2)
+		if err != nil {
+			return err
+		}
+		if idx >= meaningCount {
+			return c.errorf("invalid meaning index %d", idx)
+		}
+		return nil
+	})
+}
+
+// soundsOrdered returns whether the sounds of xs don't come after the
+// sounds of ys.
+func soundsOrdered(xs, ys []pinyin.Pinyin) bool {
+	for i := 0; i < len(xs) && i < len(ys); i++ {
+		sx, _ := xs[i].Decode()
+		sy, _ := ys[i].Decode()
+		if sx != sy {
+			return sx < sy
+		}
+	}
+	return len(xs) <= len(ys)
+}
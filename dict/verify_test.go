@@ -0,0 +1,76 @@
+package dict
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hgoes/hanyu/cedict"
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+func TestVerify(t *testing.T) {
+	if err := Main.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	// corrupt the root node of a copy of the dictionary
+	bin := append([]byte{}, Main.bin...)
+	root := 6 + 3*int(uint24(bin))
+	bin[root], bin[root+1] = 0xFF, 0xFF
+	d := Dict{
+		bin:        bin,
+		english:    Main.english,
+		pinyin:     Main.pinyin,
+		classifier: Main.classifier,
+	}
+	if err := d.Verify(); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+func fuzzSeed(f *testing.F) []byte {
+	d, err := BuildEntries([]cedict.Entry{
+		{
+			Traditional: "顯示卡",
+			Simplified:  "显示卡",
+			Pinyin: []cedict.Pinyin{
+				{Pinyin: pinyin.New(pinyin.XIAN, pinyin.Low)},
+				{Pinyin: pinyin.New(pinyin.SHI, pinyin.Falling)},
+				{Pinyin: pinyin.New(pinyin.KA, pinyin.Low)},
+			},
+			Meaning: []string{"graphics card"},
+		},
+		{
+			Traditional: "卡",
+			Simplified:  "卡",
+			Pinyin:      []cedict.Pinyin{{Literal: "ka"}},
+			Meaning:     []string{"card", "to block"},
+		},
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil {
+		f.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func FuzzLoad(f *testing.F) {
+	f.Add(fuzzSeed(f))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d, err := Load(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		// a verified dictionary must never panic
+		d.Walk(func(word []rune, m []Meaning) bool {
+			d.Lookup(word)
+			return true
+		})
+		d.SegmentBest("显示卡坏了")
+		d.SearchEnglish("card")
+		d.SearchPinyin("xian", IgnoreTones|PinyinPrefix)
+	})
+}
@@ -0,0 +1,43 @@
+package dict
+
+import "encoding/binary"
+
+// Walk calls f for every word in the dictionary together with its
+// meanings, until f returns false. The words are visited depth-first
+// in the order of their characters' code points. Words whose
+// traditional and simplified writing differ are visited once for
+// each writing. The word slice is reused between calls, so f has to
+// copy it if it needs to retain it.
+func (d *Dict) Walk(f func(word []rune, m []Meaning) bool) {
+	if len(d.bin) == 0 {
+		return
+	}
+	l := d.Begin()
+	l.walk(nil, f)
+}
+
+func (cur *Lookup) walk(
+	word []rune,
+	f func(word []rune, m []Meaning) bool,
+) bool {
+	l := int(binary.BigEndian.Uint16(cur.dict[cur.index:]))
+	for i := 0; i < l; i++ {
+		entry := cur.index + 2 + i*5
+		r := decodeRune(cur.dict, binary.BigEndian.Uint16(cur.dict[entry:]))
+		meanings := int(uint24(cur.dict[entry+2:]))
+		next := Lookup{
+			dict:     cur.dict,
+			meanings: meanings,
+			index:    meanings + 1 + int(cur.dict[meanings])*3,
+		}
+		word = append(word, r)
+		if next.IsWord() && !f(word, next.Meanings(word)) {
+			return false
+		}
+		if !next.walk(word, f) {
+			return false
+		}
+		word = word[:len(word)-1]
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package dict
+
+import "testing"
+
+func TestWalk(t *testing.T) {
+	var count int
+	var last string
+	found := false
+	Main.Walk(func(word []rune, m []Meaning) bool {
+		count++
+		w := string(word)
+		if w <= last {
+			t.Fatalf("words out of order: %q after %q", w, last)
+		}
+		last = w
+		if len(m) == 0 {
+			t.Fatalf("no meanings for %q", w)
+		}
+		if w == "不可胜数" {
+			found = true
+			if m[0].Meanings[0] != "countless" {
+				t.Errorf("wrong meaning: %q", m[0].Meanings[0])
+			}
+		}
+		return true
+	})
+	if !found {
+		t.Error("不可胜数 not visited")
+	}
+	if count < 100000 {
+		t.Error("too few words:", count)
+	}
+}
+
+func TestWalkStop(t *testing.T) {
+	var count int
+	Main.Walk(func(word []rune, m []Meaning) bool {
+		count++
+		return count < 10
+	})
+	if count != 10 {
+		t.Error("walk did not stop:", count)
+	}
+}
@@ -0,0 +1,615 @@
+// Package builder encodes dictionaries into the binary format read by
+// the dict package.
+package builder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hgoes/hanyu/cedict"
+)
+
+// Builder collects CEDICT entries and encodes them.
+type Builder struct {
+	// HSK maps words in simplified writing to their HSK level.
+	HSK map[string]byte
+	// Prefered returns whether a pronunciation is the prefered one
+	// for a word, so that it gets listed first.
+	Prefered func(word []rune, pin []cedict.Pinyin) bool
+	root     node
+	meanings []meaning
+}
+
+// Binary is an encoded dictionary, consisting of the dictionary
+// itself and the english, pinyin and classifier indices.
+type Binary struct {
+	Dict       []byte
+	English    []byte
+	Pinyin     []byte
+	Classifier []byte
+}
+
+// Add an entry to the dictionary. The traditional and simplified
+// writing must have the same number of characters.
+func (b *Builder) Add(e cedict.Entry) {
+	if b.root.Next == nil {
+		b.root.Next = make(map[rune]*node)
+	}
+	isPrefered := b.Prefered
+	if isPrefered == nil {
+		isPrefered = func([]rune, []cedict.Pinyin) bool {
+			return false
+		}
+	}
+	i := len(b.meanings)
+	trad := []rune(e.Traditional)
+	simp := []rune(e.Simplified)
+	variants := getVariant(trad, simp)
+	pref := isPrefered(trad, e.Pinyin)
+	b.root.Insert(trad, i, pref)
+	if len(variants) != 0 {
+		pref := isPrefered(simp, e.Pinyin)
+		b.root.Insert(simp, i, pref)
+	}
+	b.meanings = append(b.meanings, meaning{
+		Pinyin:      e.Pinyin,
+		Meaning:     e.Meaning,
+		HSKLevel:    b.HSK[e.Simplified],
+		Variant:     variants,
+		Traditional: e.Traditional,
+		Classifiers: e.Classifiers,
+		References:  e.References,
+		Flags:       e.Flags,
+	})
+}
+
+// Build encodes all added entries. Panics if the entries exceed the
+// limits of the binary format.
+func (b *Builder) Build() (*Binary, error) {
+	if b.root.Next == nil {
+		b.root.Next = make(map[rune]*node)
+	}
+	// create a rune encoding
+	var allRunes []rune
+	b.root.collectRunes(func(r rune) {
+		idx := sort.Search(len(allRunes), func(i int) bool {
+			return allRunes[i] >= r
+		})
+		if idx >= len(allRunes) {
+			allRunes = append(allRunes, r)
+		} else if allRunes[idx] != r {
+			allRunes = append(allRunes, 0)
+			copy(allRunes[idx+1:], allRunes[idx:len(allRunes)-1])
+			allRunes[idx] = r
+		}
+	})
+	var dict, english, pinyin, classifier buffer
+	err := createBinaryDict(&dict, &b.root, b.meanings, allRunes)
+	if err != nil {
+		return nil, err
+	}
+	err = createEnglishIndex(&english, b.meanings)
+	if err != nil {
+		return nil, err
+	}
+	err = createPinyinIndex(&pinyin, b.meanings)
+	if err != nil {
+		return nil, err
+	}
+	err = createClassifierIndex(&classifier, b.meanings)
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{
+		Dict:       dict,
+		English:    english,
+		Pinyin:     pinyin,
+		Classifier: classifier,
+	}, nil
+}
+
+// buffer is an in-memory [io.WriterAt] that grows as needed.
+type buffer []byte
+
+func (b *buffer) WriteAt(p []byte, off int64) (int, error) {
+	if end := int(off) + len(p); end > len(*b) {
+		if end > cap(*b) {
+			nb := make([]byte, end, 2*end)
+			copy(nb, *b)
+			*b = nb
+		} else {
+			*b = (*b)[:end]
+		}
+	}
+	return copy((*b)[off:], p), nil
+}
+
+type node struct {
+	Next    map[rune]*node
+	Meaning []nodeMeaning
+}
+
+type nodeMeaning struct {
+	Meaning  int
+	Prefered bool
+}
+
+type meaning struct {
+	Pinyin      []cedict.Pinyin
+	Meaning     []string
+	HSKLevel    byte
+	Variant     []variant
+	Traditional string
+	Classifiers []cedict.Word
+	References  []cedict.Reference
+	Flags       cedict.Flags
+}
+
+func (n *node) Insert(text []rune, meaning int, prefered bool) {
+	if len(text) == 0 {
+		n.Meaning = append(n.Meaning, nodeMeaning{
+			Meaning:  meaning,
+			Prefered: prefered,
+		})
+		return
+	}
+	next, ok := n.Next[text[0]]
+	if !ok {
+		next = &node{
+			Next: make(map[rune]*node),
+		}
+		n.Next[text[0]] = next
+	}
+	next.Insert(text[1:], meaning, prefered)
+}
+
+func createBinaryDict(
+	wr io.WriterAt,
+	root *node,
+	meanings []meaning,
+	runes []rune,
+) error {
+	// write the length of the rune index
+	if len(runes) > 0xFFFFFF {
+		panic("too many runes")
+	}
+	_, err := putUint24(wr, 0, uint32(len(runes)))
+	if err != nil {
+		return err
+	}
+	for i, r := range runes {
+		_, err = putUint24(wr, 3+int64(i)*3, uint32(r))
+		if err != nil {
+			return err
+		}
+	}
+	idxOffset := 3 + int64(len(runes))*3
+	// leave 3 bytes for the meanings offset
+	idxSize, err := root.binary(wr, idxOffset+3, runes)
+	if err != nil {
+		return err
+	}
+	// write the meanings offset
+	if idxSize > 0xFFFFFF {
+		panic("meanings offset too big")
+	}
+	_, err = putUint24(wr, idxOffset, uint32(idxSize))
+	if err != nil {
+		return err
+	}
+	// write the meanings
+	meaningOffset := idxOffset + idxSize + 3
+	offsetIndex := 3 * int64(len(meanings))
+	for i, m := range meanings {
+		if offsetIndex > 0xFFFFFF {
+			panic(fmt.Sprintf("offset index too big: %d", offsetIndex))
+		}
+		_, err = putUint24(wr, meaningOffset+int64(i)*3, uint32(offsetIndex))
+		if err != nil {
+			return err
+		}
+		hskSz, err := wr.WriteAt([]byte{m.HSKLevel}, meaningOffset+offsetIndex)
+		if err != nil {
+			return err
+		}
+		offsetIndex += int64(hskSz)
+		pinsz, err := binaryPinyins(wr, meaningOffset+offsetIndex, m.Pinyin)
+		if err != nil {
+			return err
+		}
+		offsetIndex += int64(pinsz)
+		msz, err := binaryMeanings(wr, meaningOffset+offsetIndex, m.Meaning)
+		if err != nil {
+			return err
+		}
+		offsetIndex += int64(msz)
+		vsz, err := binaryVariants(wr, meaningOffset+offsetIndex, m.Variant, runes)
+		if err != nil {
+			return err
+		}
+		offsetIndex += int64(vsz)
+		wsz, err := binaryHeadword(wr, meaningOffset+offsetIndex, m.Traditional)
+		if err != nil {
+			return err
+		}
+		offsetIndex += int64(wsz)
+		asz, err := binaryAnnotations(wr, meaningOffset+offsetIndex, m)
+		if err != nil {
+			return err
+		}
+		offsetIndex += int64(asz)
+	}
+	return nil
+}
+
+func binaryPinyin(
+	wr io.WriterAt,
+	offset int64,
+	p cedict.Pinyin,
+) (int, error) {
+	var buf [2]byte
+	if p.Literal == "" {
+		// the highest bit marks an encoded pinyin, the next one its
+		// capitalization
+		raw := uint16(p.Pinyin) | 0x8000
+		if p.Capitalized {
+			raw |= 0x4000
+		}
+		binary.BigEndian.PutUint16(buf[:], raw)
+		return wr.WriteAt(buf[:], offset)
+	}
+	litBytes := []byte(p.Literal)
+	if len(litBytes) > 127 {
+		panic(fmt.Sprintf("pinyin literal %q not representable", p.Literal))
+	}
+	buf[0] = byte(len(litBytes))
+	c, err := wr.WriteAt(buf[:1], offset)
+	if err != nil {
+		return 0, err
+	}
+	sz := c
+	c, err = wr.WriteAt(litBytes, offset+1)
+	if err != nil {
+		return 0, err
+	}
+	sz += c
+	return sz, nil
+}
+
+func binaryPinyins(
+	wr io.WriterAt,
+	offset int64,
+	ps []cedict.Pinyin,
+) (int, error) {
+	// write the size
+	c, err := wr.WriteAt([]byte{byte(len(ps))}, offset)
+	if err != nil {
+		return 0, err
+	}
+	sz := c
+	for _, p := range ps {
+		c, err = binaryPinyin(wr, offset+int64(sz), p)
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+	}
+	return sz, nil
+}
+
+func binaryMeanings(
+	wr io.WriterAt,
+	offset int64,
+	meanings []string,
+) (int, error) {
+	if len(meanings) > 255 {
+		panic("too many meanings")
+	}
+	c, err := wr.WriteAt([]byte{byte(len(meanings))}, offset)
+	if err != nil {
+		return 0, err
+	}
+	sz := c
+	offset += int64(c)
+	var buf [2]byte
+	for _, meaning := range meanings {
+		b := []byte(meaning)
+		if len(b) > 0xFFFF {
+			panic("meaning too large")
+		}
+		binary.BigEndian.PutUint16(buf[:], uint16(len(b)))
+		c, err = wr.WriteAt(buf[:], offset)
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+		offset += int64(c)
+		c, err = wr.WriteAt(b, offset)
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+		offset += int64(c)
+	}
+	return sz, nil
+}
+
+func encodeRune(r rune, allRunes []rune) uint16 {
+	idx := sort.Search(len(allRunes), func(i int) bool {
+		return allRunes[i] >= r
+	})
+	if idx >= len(allRunes) || allRunes[idx] != r {
+		panic("rune index not found")
+	}
+	return uint16(idx)
+}
+
+func binaryVariants(
+	wr io.WriterAt,
+	offset int64,
+	variants []variant,
+	allRunes []rune,
+) (int, error) {
+	if len(variants) > 0xFF {
+		panic("too many variants")
+	}
+	sz, err := wr.WriteAt([]byte{byte(len(variants))}, offset)
+	if err != nil {
+		return 0, err
+	}
+	offset += int64(sz)
+	for _, v := range variants {
+		var buf [5]byte
+		buf[0] = v.Pos
+		binary.BigEndian.PutUint16(buf[1:], encodeRune(v.Traditional, allRunes))
+		binary.BigEndian.PutUint16(buf[3:], encodeRune(v.Simplified, allRunes))
+		c, err := wr.WriteAt(buf[:], offset)
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+		offset += int64(c)
+	}
+	return sz, nil
+}
+
+func binaryHeadword(
+	wr io.WriterAt,
+	offset int64,
+	word string,
+) (int, error) {
+	b := []byte(word)
+	if len(b) > 0xFF {
+		panic(fmt.Sprintf("headword %q too long", word))
+	}
+	sz, err := wr.WriteAt([]byte{byte(len(b))}, offset)
+	if err != nil {
+		return 0, err
+	}
+	c, err := wr.WriteAt(b, offset+int64(sz))
+	if err != nil {
+		return 0, err
+	}
+	return sz + c, nil
+}
+
+// binaryAnnotations writes the flags, classifiers and references of a
+// meaning.
+func binaryAnnotations(
+	wr io.WriterAt,
+	offset int64,
+	m meaning,
+) (int, error) {
+	if len(m.Classifiers) > 0xFF || len(m.References) > 0xFF {
+		panic("too many annotations")
+	}
+	sz, err := wr.WriteAt([]byte{byte(m.Flags), byte(len(m.Classifiers))}, offset)
+	if err != nil {
+		return 0, err
+	}
+	for _, cl := range m.Classifiers {
+		c, err := binaryWord(wr, offset+int64(sz), cl)
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+	}
+	c, err := wr.WriteAt([]byte{byte(len(m.References))}, offset+int64(sz))
+	if err != nil {
+		return 0, err
+	}
+	sz += c
+	for _, ref := range m.References {
+		c, err = wr.WriteAt([]byte{byte(ref.Kind)}, offset+int64(sz))
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+		c, err = binaryWord(wr, offset+int64(sz), ref.Word)
+		if err != nil {
+			return 0, err
+		}
+		sz += c
+	}
+	return sz, nil
+}
+
+// binaryWord writes a word given by its traditional and simplified
+// writing, where the latter is left empty if both are the same, and
+// its pinyin.
+func binaryWord(
+	wr io.WriterAt,
+	offset int64,
+	w cedict.Word,
+) (int, error) {
+	simp := w.Simplified
+	if simp == w.Traditional {
+		simp = ""
+	}
+	sz, err := binaryHeadword(wr, offset, w.Traditional)
+	if err != nil {
+		return 0, err
+	}
+	c, err := binaryHeadword(wr, offset+int64(sz), simp)
+	if err != nil {
+		return 0, err
+	}
+	sz += c
+	if len(w.Pinyin) > 0xFF {
+		panic("too many pinyins")
+	}
+	c, err = binaryPinyins(wr, offset+int64(sz), w.Pinyin)
+	if err != nil {
+		return 0, err
+	}
+	return sz + c, nil
+}
+
+func putUint24(wr io.WriterAt, at int64, val uint32) (int, error) {
+	if val > 0x00FFFFFF {
+		panic("out of bounds for uint24")
+	}
+	var enc [3]byte
+	enc[0], enc[1], enc[2] = byte(val>>16), byte(val>>8), byte(val)
+	return wr.WriteAt(enc[:], at)
+}
+
+func putUint16(wr io.WriterAt, at int64, val uint16) (int, error) {
+	var enc [2]byte
+	enc[0], enc[1] = byte(val>>8), byte(val)
+	return wr.WriteAt(enc[:], at)
+}
+
+func (n *node) binary(
+	wr io.WriterAt,
+	off int64,
+	runes []rune,
+) (sz int64, err error) {
+	type elem struct {
+		key     rune
+		runeIdx int
+		nd      *node
+	}
+	elems := make([]elem, 0, len(n.Next))
+	for key, nd := range n.Next {
+		elems = append(elems, elem{
+			key: key,
+			nd:  nd,
+		})
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i].key < elems[j].key
+	})
+	offset := 0
+	for i := range elems {
+		idx := sort.Search(len(runes[offset:]), func(j int) bool {
+			return runes[offset+j] >= elems[i].key
+		})
+		if idx >= len(runes)-offset || runes[offset+idx] != elems[i].key {
+			panic("rune index not found")
+		}
+		elems[i].runeIdx = offset + idx
+		offset = idx + 1
+	}
+	var buf [4]byte
+	// write the length of the index
+	if len(n.Next) > 0xFFFF {
+		panic("node has too many successors")
+	}
+	binary.BigEndian.PutUint16(buf[:2], uint16(len(n.Next)))
+	c, err := wr.WriteAt(buf[:2], off)
+	if err != nil {
+		return 0, err
+	}
+	sz += int64(c)
+	off += int64(c)
+	// calculate the size of the index
+	offsetIndex := off + int64(len(n.Next))*5
+	for i := range elems {
+		// write the rune
+		if elems[i].runeIdx > 0xFFFF {
+			panic("rune too big")
+		}
+		c, err = putUint16(wr, off+int64(i)*5, uint16(elems[i].runeIdx))
+		if err != nil {
+			return 0, err
+		}
+		sz += int64(c)
+		// write the offset of the node's content
+		if offsetIndex > 0xFFFFFF {
+			panic(fmt.Sprintf("offset index too large: %d", offsetIndex))
+		}
+		c, err = putUint24(wr, off+int64(i)*5+2, uint32(offsetIndex))
+		if err != nil {
+			return 0, err
+		}
+		sz += int64(c)
+		// write the meanings
+		c, err = wr.WriteAt([]byte{byte(len(elems[i].nd.Meaning))}, offsetIndex)
+		if err != nil {
+			return 0, err
+		}
+		sz += int64(c)
+		offsetIndex += int64(c)
+		// sort the meanings so that the preferred one's is on top
+		sort.Slice(elems[i].nd.Meaning, func(x, y int) bool {
+			if elems[i].nd.Meaning[x].Prefered {
+				return true
+			}
+			return false
+		})
+		for _, m := range elems[i].nd.Meaning {
+			if m.Meaning > 0xFFFFFF {
+				panic("meaning too large")
+			}
+			c, err = putUint24(wr, offsetIndex, uint32(m.Meaning))
+			if err != nil {
+				return 0, err
+			}
+			sz += int64(c)
+			offsetIndex += int64(c)
+		}
+		// recursively write the node's content
+		ndSz, err := elems[i].nd.binary(wr, offsetIndex, runes)
+		if err != nil {
+			return 0, err
+		}
+		sz += ndSz
+		// update the offset
+		offsetIndex += ndSz
+	}
+	return sz, nil
+}
+
+func (n *node) collectRunes(f func(rune)) {
+	for r, nxt := range n.Next {
+		f(r)
+		nxt.collectRunes(f)
+	}
+}
+
+type variant struct {
+	Pos         byte
+	Traditional rune
+	Simplified  rune
+}
+
+func getVariant(traditional, simplified []rune) []variant {
+	if len(traditional) != len(simplified) {
+		panic("not of equal length")
+	}
+	var result []variant
+	for i, c := range traditional {
+		if c == simplified[i] {
+			continue
+		}
+		result = append(result, variant{
+			Pos:         byte(i),
+			Traditional: c,
+			Simplified:  simplified[i],
+		})
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package builder
+
+import (
+	"io"
+)
+
+// createClassifierIndex writes an index from classifiers, in both
+// simplified and traditional writing, to the meanings listing them
+// (see [createWordIndex]).
+func createClassifierIndex(
+	wr io.WriterAt,
+	meanings []meaning,
+) error {
+	postings := make(map[string][]int)
+	for i, m := range meanings {
+		seen := make(map[string]bool)
+		for _, cl := range m.Classifiers {
+			for _, w := range []string{cl.Simplified, cl.Traditional} {
+				if seen[w] {
+					continue
+				}
+				seen[w] = true
+				postings[w] = append(postings[w], i)
+			}
+		}
+	}
+	return createWordIndex(wr, postings, "classifier")
+}
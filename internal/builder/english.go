@@ -0,0 +1,93 @@
+package builder
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hgoes/hanyu/internal/gloss"
+)
+
+// createEnglishIndex writes an index from english words to the
+// meanings containing them (see [createWordIndex]).
+func createEnglishIndex(
+	wr io.WriterAt,
+	meanings []meaning,
+) error {
+	postings := make(map[string][]int)
+	for i, m := range meanings {
+		seen := make(map[string]bool)
+		for _, mean := range m.Meaning {
+			for _, w := range gloss.Words(gloss.Normalize(mean)) {
+				if seen[w] {
+					continue
+				}
+				seen[w] = true
+				postings[w] = append(postings[w], i)
+			}
+		}
+	}
+	return createWordIndex(wr, postings, "english")
+}
+
+// createWordIndex writes an index from words to meanings. The index
+// starts with the number of words, followed by the offset of every
+// word's entry (relative to the end of the offset table). Each entry
+// consists of the length of the word, the word itself, the number of
+// meanings and the sorted indices of the meanings.
+func createWordIndex(
+	wr io.WriterAt,
+	postings map[string][]int,
+	what string,
+) error {
+	words := make([]string, 0, len(postings))
+	for w := range postings {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	if len(words) > 0xFFFFFF {
+		panic("too many " + what + " words")
+	}
+	_, err := putUint24(wr, 0, uint32(len(words)))
+	if err != nil {
+		return err
+	}
+	dataOffset := 3 + 3*int64(len(words))
+	offset := int64(0)
+	for i, w := range words {
+		if offset > 0xFFFFFF {
+			panic(fmt.Sprintf("%s index too large: %d", what, offset))
+		}
+		_, err = putUint24(wr, 3+int64(i)*3, uint32(offset))
+		if err != nil {
+			return err
+		}
+		b := []byte(w)
+		if len(b) > 0xFF {
+			panic(fmt.Sprintf("%s word %q too long", what, w))
+		}
+		c, err := wr.WriteAt([]byte{byte(len(b))}, dataOffset+offset)
+		if err != nil {
+			return err
+		}
+		offset += int64(c)
+		c, err = wr.WriteAt(b, dataOffset+offset)
+		if err != nil {
+			return err
+		}
+		offset += int64(c)
+		c, err = putUint24(wr, dataOffset+offset, uint32(len(postings[w])))
+		if err != nil {
+			return err
+		}
+		offset += int64(c)
+		for _, idx := range postings[w] {
+			c, err = putUint24(wr, dataOffset+offset, uint32(idx))
+			if err != nil {
+				return err
+			}
+			offset += int64(c)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package builder
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Magic is the start of every encoded dictionary.
+const Magic = "HANYUDCT"
+
+// Version of the binary format.
+const Version = 4
+
+// HeaderSize is the size of the header, consisting of the magic, the
+// version and the sizes of the dictionary, the english index, the
+// pinyin index and the classifier index.
+const HeaderSize = len(Magic) + 2 + 4*4
+
+var (
+	// ErrNotDict is returned for data that doesn't start with
+	// [Magic].
+	ErrNotDict = errors.New("not an encoded dictionary")
+	// ErrVersion is returned for dictionaries with an unsupported
+	// version of the binary format.
+	ErrVersion = errors.New("unsupported dictionary version")
+)
+
+// ParseHeader checks the magic and version of a header and returns
+// the sizes of the sections.
+func ParseHeader(hdr []byte) ([4]int64, error) {
+	var sizes [4]int64
+	if len(hdr) < HeaderSize || string(hdr[:len(Magic)]) != Magic {
+		return sizes, ErrNotDict
+	}
+	hdr = hdr[len(Magic):]
+	if v := binary.BigEndian.Uint16(hdr); v != Version {
+		return sizes, fmt.Errorf("%w: %d", ErrVersion, v)
+	}
+	hdr = hdr[2:]
+	for i := range sizes {
+		sizes[i] = int64(binary.BigEndian.Uint32(hdr[i*4:]))
+	}
+	return sizes, nil
+}
+
+// Parse splits an encoded dictionary into its sections, without
+// copying it.
+func Parse(data []byte) (*Binary, error) {
+	sizes, err := ParseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	var sections [4][]byte
+	pos := int64(HeaderSize)
+	for i, sz := range sizes {
+		if sz > int64(len(data))-pos {
+			return nil, io.ErrUnexpectedEOF
+		}
+		sections[i] = data[pos : pos+sz]
+		pos += sz
+	}
+	return &Binary{
+		Dict:       sections[0],
+		English:    sections[1],
+		Pinyin:     sections[2],
+		Classifier: sections[3],
+	}, nil
+}
+
+// WriteTo writes the header followed by all sections.
+func (b *Binary) WriteTo(w io.Writer) (int64, error) {
+	hdr := make([]byte, HeaderSize)
+	copy(hdr, Magic)
+	binary.BigEndian.PutUint16(hdr[len(Magic):], Version)
+	for i, sec := range [][]byte{b.Dict, b.English, b.Pinyin, b.Classifier} {
+		if int64(len(sec)) > 0xFFFFFFFF {
+			return 0, fmt.Errorf("section %d too large", i)
+		}
+		binary.BigEndian.PutUint32(hdr[len(Magic)+2+i*4:], uint32(len(sec)))
+	}
+	var total int64
+	for _, sec := range [][]byte{hdr, b.Dict, b.English, b.Pinyin, b.Classifier} {
+		n, err := w.Write(sec)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,115 @@
+package builder
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hgoes/hanyu/pinyin"
+)
+
+// createPinyinIndex writes an index of the pronunciations of all
+// meanings, sorted by their sounds and then by their tones. Meanings
+// with literal pinyins are left out. The index starts with the number
+// of entries, followed by the offset of every entry (relative to the
+// end of the offset table). Each entry consists of the number of
+// syllables, the syllables and the index of the meaning.
+func createPinyinIndex(
+	wr io.WriterAt,
+	meanings []meaning,
+) error {
+	type entry struct {
+		pinyins []pinyin.Pinyin
+		meaning int
+	}
+	var entries []entry
+NEXT_MEANING:
+	for i, m := range meanings {
+		pins := make([]pinyin.Pinyin, len(m.Pinyin))
+		for j, p := range m.Pinyin {
+			if p.Literal != "" {
+				continue NEXT_MEANING
+			}
+			pins[j] = p.Pinyin
+		}
+		if len(pins) == 0 {
+			continue
+		}
+		if len(pins) > 0xFF {
+			panic("too many syllables")
+		}
+		entries = append(entries, entry{
+			pinyins: pins,
+			meaning: i,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return comparePinyins(entries[i].pinyins, entries[j].pinyins) < 0
+	})
+	if len(entries) > 0xFFFFFF {
+		panic("too many pinyin entries")
+	}
+	_, err := putUint24(wr, 0, uint32(len(entries)))
+	if err != nil {
+		return err
+	}
+	dataOffset := 3 + 3*int64(len(entries))
+	offset := int64(0)
+	for i, e := range entries {
+		if offset > 0xFFFFFF {
+			panic(fmt.Sprintf("pinyin index too large: %d", offset))
+		}
+		_, err = putUint24(wr, 3+int64(i)*3, uint32(offset))
+		if err != nil {
+			return err
+		}
+		c, err := wr.WriteAt([]byte{byte(len(e.pinyins))}, dataOffset+offset)
+		if err != nil {
+			return err
+		}
+		offset += int64(c)
+		for _, p := range e.pinyins {
+			c, err = putUint16(wr, dataOffset+offset, uint16(p))
+			if err != nil {
+				return err
+			}
+			offset += int64(c)
+		}
+		c, err = putUint24(wr, dataOffset+offset, uint32(e.meaning))
+		if err != nil {
+			return err
+		}
+		offset += int64(c)
+	}
+	return nil
+}
+
+// comparePinyins orders pinyin sequences by their sounds first, and
+// only then by their tones.
+func comparePinyins(xs, ys []pinyin.Pinyin) int {
+	for i := 0; i < len(xs) && i < len(ys); i++ {
+		sx, _ := xs[i].Decode()
+		sy, _ := ys[i].Decode()
+		if sx != sy {
+			if sx < sy {
+				return -1
+			}
+			return 1
+		}
+	}
+	if len(xs) != len(ys) {
+		if len(xs) < len(ys) {
+			return -1
+		}
+		return 1
+	}
+	for i := range xs {
+		if xs[i] != ys[i] {
+			if xs[i] < ys[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,55 @@
+// Package gloss normalizes english glosses, so that they can be
+// indexed and searched.
+package gloss
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize converts a gloss to lower case, removes remarks in
+// parentheses as well as a leading "to " and collapses white space.
+func Normalize(gloss string) string {
+	var buf strings.Builder
+	depth := 0
+	for _, r := range strings.ToLower(gloss) {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			buf.WriteRune(r)
+		}
+	}
+	result := strings.Join(strings.Fields(buf.String()), " ")
+	return strings.TrimPrefix(result, "to ")
+}
+
+var stopWords = map[string]bool{
+	"a":   true,
+	"an":  true,
+	"and": true,
+	"etc": true,
+	"of":  true,
+	"or":  true,
+	"s":   true,
+	"sb":  true,
+	"sth": true,
+	"the": true,
+	"to":  true,
+}
+
+// Words splits a normalized gloss into the words that get indexed.
+// Stop words like "the" or "to" are left out.
+func Words(normalized string) []string {
+	var result []string
+	for _, w := range strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !stopWords[w] {
+			result = append(result, w)
+		}
+	}
+	return result
+}
@@ -0,0 +1,138 @@
+package pinyin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Erhua is a pinyin syllable with an optional rhotic suffix (儿化),
+// like wánr. The suffix is written with the character 儿, which has
+// its own syllable r5 in dictionaries (see [JoinErhua] and
+// [SplitErhua]).
+type Erhua struct {
+	Pinyin Pinyin
+	R      bool
+}
+
+// String renders a syllable using diacritics, followed by r if it has
+// the rhotic suffix.
+func (e Erhua) String() string {
+	if e.R {
+		return e.Pinyin.String() + "r"
+	}
+	return e.Pinyin.String()
+}
+
+// JoinErhua joins syllables r into the preceding syllable as rhotic
+// suffix, e.g. wán r becomes wánr.
+func JoinErhua(ps []Pinyin) []Erhua {
+	result := make([]Erhua, 0, len(ps))
+	for _, p := range ps {
+		sound, _ := p.Decode()
+		if n := len(result); sound == R && n > 0 && !result[n-1].R {
+			if prev, _ := result[n-1].Pinyin.Decode(); prev != R {
+				result[n-1].R = true
+				continue
+			}
+		}
+		result = append(result, Erhua{Pinyin: p})
+	}
+	return result
+}
+
+// SplitErhua splits rhotic suffixes into syllables r of the neutral
+// tone, so that every syllable corresponds to a character, like in
+// dictionary entries (玩儿 wán r).
+func SplitErhua(es []Erhua) []Pinyin {
+	result := make([]Pinyin, 0, len(es))
+	for _, e := range es {
+		result = append(result, e.Pinyin)
+		if e.R {
+			result = append(result, New(R, Neutral))
+		}
+	}
+	return result
+}
+
+// ParseErhua parses a single pinyin with an optional rhotic suffix
+// from a slice of runes. Like [Parse], but an r after a syllable is
+// parsed as suffix, unless it starts the next syllable (wánrén is wán
+// rén). A tone number may follow the syllable or the suffix (wan2r,
+// wanr2), a 5 after the suffix is ignored (wan2r5). Returns false if
+// no pinyin could be parsed, otherwise true, the parsed pinyin and the
+// remaining slice of runes.
+func ParseErhua(str []rune) (bool, Erhua, []rune) {
+	p := parser{Erhua: true}
+	var lastIdx int
+	var lastResult Erhua
+	for i, r := range str {
+		if !p.Advance(r) {
+			break
+		}
+		if p.R && !p.RDone && i+1 < len(str) {
+			if letter, _ := unmarkLetter(str[i+1]); strings.ContainsRune("aeiou", letter) {
+				break
+			}
+		}
+		ok, result := p.Result()
+		if ok {
+			lastIdx = i + 1
+			lastResult = Erhua{Pinyin: result, R: p.R}
+		}
+	}
+	if lastIdx == 0 {
+		return false, Erhua{}, nil
+	}
+	return true, lastResult, str[lastIdx:]
+}
+
+// ParseManyErhua parses many runes with optional rhotic suffixes until
+// all runes are consumed, or a parse error is encountered.
+func ParseManyErhua(str []rune) ([]Erhua, []rune) {
+	var result []Erhua
+	for {
+		if len(str) == 0 {
+			return result, nil
+		}
+		ok, e, rest := ParseErhua(str)
+		if !ok {
+			return result, str
+		}
+		result = append(result, e)
+		str = rest
+	}
+}
+
+// RenderManyErhua renders a slice of pinyins with optional rhotic
+// suffixes using diacritics.
+func RenderManyErhua(es []Erhua) string {
+	var buf strings.Builder
+	RenderManyErhuaWriter(&buf, es)
+	return buf.String()
+}
+
+// RenderManyErhuaWriter renders a slice of pinyins with optional
+// rhotic suffixes to a writer.
+func RenderManyErhuaWriter(w io.Writer, es []Erhua) (int, error) {
+	c := 0
+	for i, e := range es {
+		str, special := e.Pinyin.render()
+		if i != 0 && special {
+			n, err := fmt.Fprint(w, "'")
+			c += n
+			if err != nil {
+				return c, err
+			}
+		}
+		if e.R {
+			str += "r"
+		}
+		n, err := fmt.Fprint(w, str)
+		c += n
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
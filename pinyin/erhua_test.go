@@ -0,0 +1,86 @@
+package pinyin
+
+import "testing"
+
+func TestParseErhua(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output string
+		Rest   string
+	}{
+		{"wánr", "wánr", ""},
+		{"Wánr", "wánr", ""},
+		{"wan2r", "wánr", ""},
+		{"wan2r5", "wánr", ""},
+		{"wanr2", "wánr", ""},
+		{"nǚr", "nǚr", ""},
+		{"ér", "ér", ""},
+		{"er4", "èr", ""},
+		{"r5", "r", ""},
+		// r starting the next syllable
+		{"wánrén", "wán", "rén"},
+		{"wán'r", "wán", "r"},
+		{"wánr1", "wánr", "1"},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			ok, e, rest := ParseErhua([]rune(test.Input))
+			if !ok {
+				t.Fatal("failed to parse")
+			}
+			if e.String() != test.Output {
+				t.Errorf("wrong result: %s", e)
+			}
+			if string(rest) != test.Rest {
+				t.Errorf("wrong rest: %q", string(rest))
+			}
+		})
+	}
+}
+
+func TestParseManyErhua(t *testing.T) {
+	result, rest := ParseManyErhua([]rune("yìdiǎnr"))
+	if len(rest) != 0 || len(result) != 2 {
+		t.Fatalf("wrong result: %v", result)
+	}
+	if !result[1].R || result[0].R {
+		t.Errorf("wrong suffixes: %v", result)
+	}
+	if str := RenderManyErhua(result); str != "yìdiǎnr" {
+		t.Errorf("wrong rendering: %s", str)
+	}
+	result, _ = ParseManyErhua([]rune("hǎowánrén"))
+	if str := RenderManyErhua(result); str != "hǎowánrén" || len(result) != 3 {
+		t.Errorf("wrong result: %v", result)
+	}
+	result, _ = ParseManyErhua([]rune("wanr en"))
+	if str := RenderManyErhua(result); str != "wanr'en" {
+		t.Errorf("wrong rendering: %s", str)
+	}
+}
+
+func TestJoinErhua(t *testing.T) {
+	// CEDICT notation of 玩儿 and 一点儿
+	ps, _ := ParseMany([]rune("wan2 r5 yi1 dian3 r5"))
+	if len(ps) != 5 {
+		t.Fatalf("wrong number of syllables: %d", len(ps))
+	}
+	es := JoinErhua(ps)
+	if str := RenderManyErhua(es); str != "wánryīdiǎnr" || len(es) != 3 {
+		t.Errorf("wrong result: %v", es)
+	}
+	split := SplitErhua(es)
+	if len(split) != len(ps) {
+		t.Fatalf("wrong number of syllables: %d", len(split))
+	}
+	for i := range ps {
+		if split[i] != ps[i] {
+			t.Errorf("syllable %d is %s, expected %s", i, split[i], ps[i])
+		}
+	}
+	// a leading r has no syllable to join
+	es = JoinErhua([]Pinyin{New(R, Neutral), New(WAN, Rising)})
+	if len(es) != 2 || es[0].R || es[1].R {
+		t.Errorf("wrong result: %v", es)
+	}
+}
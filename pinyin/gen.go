@@ -4369,3 +4369,10494 @@ func (p Sound) special() bool {
 		return false
 	}
 }
+
+const (
+	NoInitial Initial = iota
+	InitialZH
+	InitialCH
+	InitialSH
+	InitialB
+	InitialP
+	InitialM
+	InitialF
+	InitialD
+	InitialT
+	InitialN
+	InitialL
+	InitialG
+	InitialK
+	InitialH
+	InitialJ
+	InitialQ
+	InitialX
+	InitialR
+	InitialZ
+	InitialC
+	InitialS
+)
+
+func (p Initial) String() string {
+	switch p {
+	case NoInitial:
+		return "∅"
+	case InitialZH:
+		return "zh"
+	case InitialCH:
+		return "ch"
+	case InitialSH:
+		return "sh"
+	case InitialB:
+		return "b"
+	case InitialP:
+		return "p"
+	case InitialM:
+		return "m"
+	case InitialF:
+		return "f"
+	case InitialD:
+		return "d"
+	case InitialT:
+		return "t"
+	case InitialN:
+		return "n"
+	case InitialL:
+		return "l"
+	case InitialG:
+		return "g"
+	case InitialK:
+		return "k"
+	case InitialH:
+		return "h"
+	case InitialJ:
+		return "j"
+	case InitialQ:
+		return "q"
+	case InitialX:
+		return "x"
+	case InitialR:
+		return "r"
+	case InitialZ:
+		return "z"
+	case InitialC:
+		return "c"
+	case InitialS:
+		return "s"
+	default:
+		return "?"
+	}
+}
+
+const (
+	FinalA Final = iota
+	FinalO
+	FinalE
+	FinalER
+	FinalAI
+	FinalAO
+	FinalOU
+	FinalAN
+	FinalEN
+	FinalANG
+	FinalENG
+	FinalI
+	FinalIA
+	FinalIAO
+	FinalIE
+	FinalIU
+	FinalIAN
+	FinalIN
+	FinalIANG
+	FinalING
+	FinalIONG
+	FinalU
+	FinalUA
+	FinalUO
+	FinalUAI
+	FinalUI
+	FinalUAN
+	FinalUN
+	FinalUANG
+	FinalUENG
+	FinalÜ
+	FinalÜE
+	FinalÜAN
+	FinalÜN
+	FinalEI
+	FinalONG
+	FinalN
+	FinalNG
+	FinalM
+	FinalIO
+	FinalR
+)
+
+func (p Final) String() string {
+	switch p {
+	case FinalA:
+		return "a"
+	case FinalO:
+		return "o"
+	case FinalE:
+		return "e"
+	case FinalER:
+		return "er"
+	case FinalAI:
+		return "ai"
+	case FinalAO:
+		return "ao"
+	case FinalOU:
+		return "ou"
+	case FinalAN:
+		return "an"
+	case FinalEN:
+		return "en"
+	case FinalANG:
+		return "ang"
+	case FinalENG:
+		return "eng"
+	case FinalI:
+		return "i"
+	case FinalIA:
+		return "ia"
+	case FinalIAO:
+		return "iao"
+	case FinalIE:
+		return "ie"
+	case FinalIU:
+		return "iu"
+	case FinalIAN:
+		return "ian"
+	case FinalIN:
+		return "in"
+	case FinalIANG:
+		return "iang"
+	case FinalING:
+		return "ing"
+	case FinalIONG:
+		return "iong"
+	case FinalU:
+		return "u"
+	case FinalUA:
+		return "ua"
+	case FinalUO:
+		return "uo"
+	case FinalUAI:
+		return "uai"
+	case FinalUI:
+		return "ui"
+	case FinalUAN:
+		return "uan"
+	case FinalUN:
+		return "un"
+	case FinalUANG:
+		return "uang"
+	case FinalUENG:
+		return "ueng"
+	case FinalÜ:
+		return "ü"
+	case FinalÜE:
+		return "üe"
+	case FinalÜAN:
+		return "üan"
+	case FinalÜN:
+		return "ün"
+	case FinalEI:
+		return "ei"
+	case FinalONG:
+		return "ong"
+	case FinalN:
+		return "n"
+	case FinalNG:
+		return "ng"
+	case FinalM:
+		return "m"
+	case FinalIO:
+		return "io"
+	case FinalR:
+		return "r"
+	default:
+		return "?"
+	}
+}
+
+// Initial returns the initial of a sound.
+func (p Sound) Initial() Initial {
+	switch p {
+	case BA:
+		return InitialB
+	case BO:
+		return InitialB
+	case BAI:
+		return InitialB
+	case BEI:
+		return InitialB
+	case BAO:
+		return InitialB
+	case BAN:
+		return InitialB
+	case BEN:
+		return InitialB
+	case BANG:
+		return InitialB
+	case BENG:
+		return InitialB
+	case BI:
+		return InitialB
+	case BIAO:
+		return InitialB
+	case BIE:
+		return InitialB
+	case BIAN:
+		return InitialB
+	case BIN:
+		return InitialB
+	case BING:
+		return InitialB
+	case BU:
+		return InitialB
+	case PA:
+		return InitialP
+	case PO:
+		return InitialP
+	case PAI:
+		return InitialP
+	case PEI:
+		return InitialP
+	case PAO:
+		return InitialP
+	case POU:
+		return InitialP
+	case PAN:
+		return InitialP
+	case PEN:
+		return InitialP
+	case PANG:
+		return InitialP
+	case PENG:
+		return InitialP
+	case PI:
+		return InitialP
+	case PIAO:
+		return InitialP
+	case PIE:
+		return InitialP
+	case PIAN:
+		return InitialP
+	case PIN:
+		return InitialP
+	case PING:
+		return InitialP
+	case PU:
+		return InitialP
+	case MA:
+		return InitialM
+	case MO:
+		return InitialM
+	case ME:
+		return InitialM
+	case MAI:
+		return InitialM
+	case MEI:
+		return InitialM
+	case MAO:
+		return InitialM
+	case MOU:
+		return InitialM
+	case MAN:
+		return InitialM
+	case MEN:
+		return InitialM
+	case MANG:
+		return InitialM
+	case MENG:
+		return InitialM
+	case MI:
+		return InitialM
+	case MIAO:
+		return InitialM
+	case MIE:
+		return InitialM
+	case MIU:
+		return InitialM
+	case MIAN:
+		return InitialM
+	case MIN:
+		return InitialM
+	case MING:
+		return InitialM
+	case MU:
+		return InitialM
+	case FA:
+		return InitialF
+	case FO:
+		return InitialF
+	case FEI:
+		return InitialF
+	case FOU:
+		return InitialF
+	case FAN:
+		return InitialF
+	case FEN:
+		return InitialF
+	case FANG:
+		return InitialF
+	case FENG:
+		return InitialF
+	case FU:
+		return InitialF
+	case DA:
+		return InitialD
+	case DE:
+		return InitialD
+	case DAI:
+		return InitialD
+	case DEI:
+		return InitialD
+	case DAO:
+		return InitialD
+	case DOU:
+		return InitialD
+	case DAN:
+		return InitialD
+	case DEN:
+		return InitialD
+	case DANG:
+		return InitialD
+	case DENG:
+		return InitialD
+	case DONG:
+		return InitialD
+	case DI:
+		return InitialD
+	case DIAO:
+		return InitialD
+	case DIE:
+		return InitialD
+	case DIU:
+		return InitialD
+	case DIAN:
+		return InitialD
+	case DING:
+		return InitialD
+	case DU:
+		return InitialD
+	case DUO:
+		return InitialD
+	case DUI:
+		return InitialD
+	case DUAN:
+		return InitialD
+	case DUN:
+		return InitialD
+	case TA:
+		return InitialT
+	case TE:
+		return InitialT
+	case TAI:
+		return InitialT
+	case TEI:
+		return InitialT
+	case TAO:
+		return InitialT
+	case TOU:
+		return InitialT
+	case TAN:
+		return InitialT
+	case TANG:
+		return InitialT
+	case TENG:
+		return InitialT
+	case TONG:
+		return InitialT
+	case TI:
+		return InitialT
+	case TIAO:
+		return InitialT
+	case TIE:
+		return InitialT
+	case TIAN:
+		return InitialT
+	case TING:
+		return InitialT
+	case TU:
+		return InitialT
+	case TUO:
+		return InitialT
+	case TUI:
+		return InitialT
+	case TUAN:
+		return InitialT
+	case TUN:
+		return InitialT
+	case NA:
+		return InitialN
+	case NE:
+		return InitialN
+	case NAI:
+		return InitialN
+	case NEI:
+		return InitialN
+	case NAO:
+		return InitialN
+	case NOU:
+		return InitialN
+	case NAN:
+		return InitialN
+	case NEN:
+		return InitialN
+	case NANG:
+		return InitialN
+	case NENG:
+		return InitialN
+	case NONG:
+		return InitialN
+	case NI:
+		return InitialN
+	case NIAO:
+		return InitialN
+	case NIE:
+		return InitialN
+	case NIU:
+		return InitialN
+	case NIAN:
+		return InitialN
+	case NIN:
+		return InitialN
+	case NIANG:
+		return InitialN
+	case NING:
+		return InitialN
+	case NU:
+		return InitialN
+	case NUO:
+		return InitialN
+	case NUAN:
+		return InitialN
+	case NÜ:
+		return InitialN
+	case NÜE:
+		return InitialN
+	case LA:
+		return InitialL
+	case LE:
+		return InitialL
+	case LAI:
+		return InitialL
+	case LEI:
+		return InitialL
+	case LAO:
+		return InitialL
+	case LOU:
+		return InitialL
+	case LAN:
+		return InitialL
+	case LANG:
+		return InitialL
+	case LENG:
+		return InitialL
+	case LONG:
+		return InitialL
+	case LI:
+		return InitialL
+	case LIA:
+		return InitialL
+	case LIAO:
+		return InitialL
+	case LIE:
+		return InitialL
+	case LIU:
+		return InitialL
+	case LIAN:
+		return InitialL
+	case LIN:
+		return InitialL
+	case LIANG:
+		return InitialL
+	case LING:
+		return InitialL
+	case LU:
+		return InitialL
+	case LUO:
+		return InitialL
+	case LUAN:
+		return InitialL
+	case LUN:
+		return InitialL
+	case LÜ:
+		return InitialL
+	case LÜE:
+		return InitialL
+	case GA:
+		return InitialG
+	case GE:
+		return InitialG
+	case GAI:
+		return InitialG
+	case GEI:
+		return InitialG
+	case GAO:
+		return InitialG
+	case GOU:
+		return InitialG
+	case GAN:
+		return InitialG
+	case GEN:
+		return InitialG
+	case GANG:
+		return InitialG
+	case GENG:
+		return InitialG
+	case GONG:
+		return InitialG
+	case GU:
+		return InitialG
+	case GUA:
+		return InitialG
+	case GUO:
+		return InitialG
+	case GUAI:
+		return InitialG
+	case GUI:
+		return InitialG
+	case GUAN:
+		return InitialG
+	case GUN:
+		return InitialG
+	case GUANG:
+		return InitialG
+	case KA:
+		return InitialK
+	case KE:
+		return InitialK
+	case KAI:
+		return InitialK
+	case KEI:
+		return InitialK
+	case KAO:
+		return InitialK
+	case KOU:
+		return InitialK
+	case KAN:
+		return InitialK
+	case KEN:
+		return InitialK
+	case KANG:
+		return InitialK
+	case KENG:
+		return InitialK
+	case KONG:
+		return InitialK
+	case KU:
+		return InitialK
+	case KUA:
+		return InitialK
+	case KUO:
+		return InitialK
+	case KUAI:
+		return InitialK
+	case KUI:
+		return InitialK
+	case KUAN:
+		return InitialK
+	case KUN:
+		return InitialK
+	case KUANG:
+		return InitialK
+	case HA:
+		return InitialH
+	case HE:
+		return InitialH
+	case HAI:
+		return InitialH
+	case HEI:
+		return InitialH
+	case HAO:
+		return InitialH
+	case HOU:
+		return InitialH
+	case HAN:
+		return InitialH
+	case HEN:
+		return InitialH
+	case HANG:
+		return InitialH
+	case HENG:
+		return InitialH
+	case HONG:
+		return InitialH
+	case HU:
+		return InitialH
+	case HUA:
+		return InitialH
+	case HUO:
+		return InitialH
+	case HUAI:
+		return InitialH
+	case HUI:
+		return InitialH
+	case HUAN:
+		return InitialH
+	case HUN:
+		return InitialH
+	case HUANG:
+		return InitialH
+	case ZA:
+		return InitialZ
+	case ZE:
+		return InitialZ
+	case ZI:
+		return InitialZ
+	case ZAI:
+		return InitialZ
+	case ZEI:
+		return InitialZ
+	case ZAO:
+		return InitialZ
+	case ZOU:
+		return InitialZ
+	case ZAN:
+		return InitialZ
+	case ZEN:
+		return InitialZ
+	case ZANG:
+		return InitialZ
+	case ZENG:
+		return InitialZ
+	case ZONG:
+		return InitialZ
+	case ZU:
+		return InitialZ
+	case ZUO:
+		return InitialZ
+	case ZUI:
+		return InitialZ
+	case ZUAN:
+		return InitialZ
+	case ZUN:
+		return InitialZ
+	case CA:
+		return InitialC
+	case CE:
+		return InitialC
+	case CI:
+		return InitialC
+	case CAI:
+		return InitialC
+	case CAO:
+		return InitialC
+	case COU:
+		return InitialC
+	case CAN:
+		return InitialC
+	case CEN:
+		return InitialC
+	case CANG:
+		return InitialC
+	case CENG:
+		return InitialC
+	case CONG:
+		return InitialC
+	case CU:
+		return InitialC
+	case CUO:
+		return InitialC
+	case CUI:
+		return InitialC
+	case CUAN:
+		return InitialC
+	case CUN:
+		return InitialC
+	case SA:
+		return InitialS
+	case SE:
+		return InitialS
+	case SI:
+		return InitialS
+	case SAI:
+		return InitialS
+	case SAO:
+		return InitialS
+	case SOU:
+		return InitialS
+	case SAN:
+		return InitialS
+	case SEN:
+		return InitialS
+	case SANG:
+		return InitialS
+	case SENG:
+		return InitialS
+	case SONG:
+		return InitialS
+	case SU:
+		return InitialS
+	case SUO:
+		return InitialS
+	case SUI:
+		return InitialS
+	case SUAN:
+		return InitialS
+	case SUN:
+		return InitialS
+	case ZHA:
+		return InitialZH
+	case ZHE:
+		return InitialZH
+	case ZHI:
+		return InitialZH
+	case ZHAI:
+		return InitialZH
+	case ZHEI:
+		return InitialZH
+	case ZHAO:
+		return InitialZH
+	case ZHOU:
+		return InitialZH
+	case ZHAN:
+		return InitialZH
+	case ZHEN:
+		return InitialZH
+	case ZHANG:
+		return InitialZH
+	case ZHENG:
+		return InitialZH
+	case ZHONG:
+		return InitialZH
+	case ZHU:
+		return InitialZH
+	case ZHUA:
+		return InitialZH
+	case ZHUO:
+		return InitialZH
+	case ZHUAI:
+		return InitialZH
+	case ZHUI:
+		return InitialZH
+	case ZHUAN:
+		return InitialZH
+	case ZHUN:
+		return InitialZH
+	case ZHUANG:
+		return InitialZH
+	case CHA:
+		return InitialCH
+	case CHE:
+		return InitialCH
+	case CHI:
+		return InitialCH
+	case CHAI:
+		return InitialCH
+	case CHAO:
+		return InitialCH
+	case CHOU:
+		return InitialCH
+	case CHAN:
+		return InitialCH
+	case CHEN:
+		return InitialCH
+	case CHANG:
+		return InitialCH
+	case CHENG:
+		return InitialCH
+	case CHONG:
+		return InitialCH
+	case CHU:
+		return InitialCH
+	case CHUA:
+		return InitialCH
+	case CHUO:
+		return InitialCH
+	case CHUAI:
+		return InitialCH
+	case CHUI:
+		return InitialCH
+	case CHUAN:
+		return InitialCH
+	case CHUN:
+		return InitialCH
+	case CHUANG:
+		return InitialCH
+	case SHA:
+		return InitialSH
+	case SHE:
+		return InitialSH
+	case SHI:
+		return InitialSH
+	case SHAI:
+		return InitialSH
+	case SHEI:
+		return InitialSH
+	case SHAO:
+		return InitialSH
+	case SHOU:
+		return InitialSH
+	case SHAN:
+		return InitialSH
+	case SHEN:
+		return InitialSH
+	case SHANG:
+		return InitialSH
+	case SHENG:
+		return InitialSH
+	case SHU:
+		return InitialSH
+	case SHUA:
+		return InitialSH
+	case SHUO:
+		return InitialSH
+	case SHUAI:
+		return InitialSH
+	case SHUI:
+		return InitialSH
+	case SHUAN:
+		return InitialSH
+	case SHUN:
+		return InitialSH
+	case SHUANG:
+		return InitialSH
+	case RE:
+		return InitialR
+	case RI:
+		return InitialR
+	case RAO:
+		return InitialR
+	case ROU:
+		return InitialR
+	case RAN:
+		return InitialR
+	case REN:
+		return InitialR
+	case RANG:
+		return InitialR
+	case RENG:
+		return InitialR
+	case RONG:
+		return InitialR
+	case RU:
+		return InitialR
+	case RUA:
+		return InitialR
+	case RUO:
+		return InitialR
+	case RUI:
+		return InitialR
+	case RUAN:
+		return InitialR
+	case RUN:
+		return InitialR
+	case JI:
+		return InitialJ
+	case JIA:
+		return InitialJ
+	case JIAO:
+		return InitialJ
+	case JIE:
+		return InitialJ
+	case JIU:
+		return InitialJ
+	case JIAN:
+		return InitialJ
+	case JIN:
+		return InitialJ
+	case JIANG:
+		return InitialJ
+	case JING:
+		return InitialJ
+	case JIONG:
+		return InitialJ
+	case JU:
+		return InitialJ
+	case JUE:
+		return InitialJ
+	case JUAN:
+		return InitialJ
+	case JUN:
+		return InitialJ
+	case QI:
+		return InitialQ
+	case QIA:
+		return InitialQ
+	case QIAO:
+		return InitialQ
+	case QIE:
+		return InitialQ
+	case QIU:
+		return InitialQ
+	case QIAN:
+		return InitialQ
+	case QIN:
+		return InitialQ
+	case QIANG:
+		return InitialQ
+	case QING:
+		return InitialQ
+	case QIONG:
+		return InitialQ
+	case QU:
+		return InitialQ
+	case QUE:
+		return InitialQ
+	case QUAN:
+		return InitialQ
+	case QUN:
+		return InitialQ
+	case XI:
+		return InitialX
+	case XIA:
+		return InitialX
+	case XIAO:
+		return InitialX
+	case XIE:
+		return InitialX
+	case XIU:
+		return InitialX
+	case XIAN:
+		return InitialX
+	case XIN:
+		return InitialX
+	case XIANG:
+		return InitialX
+	case XING:
+		return InitialX
+	case XIONG:
+		return InitialX
+	case XU:
+		return InitialX
+	case XUE:
+		return InitialX
+	case XUAN:
+		return InitialX
+	case XUN:
+		return InitialX
+	case FIAO:
+		return InitialF
+	case HM:
+		return InitialH
+	case LO:
+		return InitialL
+	case NUN:
+		return InitialN
+	case CEI:
+		return InitialC
+	case DIN:
+		return InitialD
+	case BIANG:
+		return InitialB
+	default:
+		return NoInitial
+	}
+}
+
+// Final returns the final of a sound. It's spelled as after a
+// consonant, e.g. the final of you is iu and the final of ju is ü.
+func (p Sound) Final() Final {
+	switch p {
+	case A:
+		return FinalA
+	case O:
+		return FinalO
+	case E:
+		return FinalE
+	case ER:
+		return FinalER
+	case AI:
+		return FinalAI
+	case AO:
+		return FinalAO
+	case OU:
+		return FinalOU
+	case AN:
+		return FinalAN
+	case EN:
+		return FinalEN
+	case ANG:
+		return FinalANG
+	case ENG:
+		return FinalENG
+	case YI:
+		return FinalI
+	case YA:
+		return FinalIA
+	case YAO:
+		return FinalIAO
+	case YE:
+		return FinalIE
+	case YOU:
+		return FinalIU
+	case YAN:
+		return FinalIAN
+	case YIN:
+		return FinalIN
+	case YANG:
+		return FinalIANG
+	case YING:
+		return FinalING
+	case YONG:
+		return FinalIONG
+	case WU:
+		return FinalU
+	case WA:
+		return FinalUA
+	case WO:
+		return FinalUO
+	case WAI:
+		return FinalUAI
+	case WEI:
+		return FinalUI
+	case WAN:
+		return FinalUAN
+	case WEN:
+		return FinalUN
+	case WANG:
+		return FinalUANG
+	case WENG:
+		return FinalUENG
+	case YU:
+		return FinalÜ
+	case YUE:
+		return FinalÜE
+	case YUAN:
+		return FinalÜAN
+	case YUN:
+		return FinalÜN
+	case BA:
+		return FinalA
+	case BO:
+		return FinalO
+	case BAI:
+		return FinalAI
+	case BEI:
+		return FinalEI
+	case BAO:
+		return FinalAO
+	case BAN:
+		return FinalAN
+	case BEN:
+		return FinalEN
+	case BANG:
+		return FinalANG
+	case BENG:
+		return FinalENG
+	case BI:
+		return FinalI
+	case BIAO:
+		return FinalIAO
+	case BIE:
+		return FinalIE
+	case BIAN:
+		return FinalIAN
+	case BIN:
+		return FinalIN
+	case BING:
+		return FinalING
+	case BU:
+		return FinalU
+	case PA:
+		return FinalA
+	case PO:
+		return FinalO
+	case PAI:
+		return FinalAI
+	case PEI:
+		return FinalEI
+	case PAO:
+		return FinalAO
+	case POU:
+		return FinalOU
+	case PAN:
+		return FinalAN
+	case PEN:
+		return FinalEN
+	case PANG:
+		return FinalANG
+	case PENG:
+		return FinalENG
+	case PI:
+		return FinalI
+	case PIAO:
+		return FinalIAO
+	case PIE:
+		return FinalIE
+	case PIAN:
+		return FinalIAN
+	case PIN:
+		return FinalIN
+	case PING:
+		return FinalING
+	case PU:
+		return FinalU
+	case MA:
+		return FinalA
+	case MO:
+		return FinalO
+	case ME:
+		return FinalE
+	case MAI:
+		return FinalAI
+	case MEI:
+		return FinalEI
+	case MAO:
+		return FinalAO
+	case MOU:
+		return FinalOU
+	case MAN:
+		return FinalAN
+	case MEN:
+		return FinalEN
+	case MANG:
+		return FinalANG
+	case MENG:
+		return FinalENG
+	case MI:
+		return FinalI
+	case MIAO:
+		return FinalIAO
+	case MIE:
+		return FinalIE
+	case MIU:
+		return FinalIU
+	case MIAN:
+		return FinalIAN
+	case MIN:
+		return FinalIN
+	case MING:
+		return FinalING
+	case MU:
+		return FinalU
+	case FA:
+		return FinalA
+	case FO:
+		return FinalO
+	case FEI:
+		return FinalEI
+	case FOU:
+		return FinalOU
+	case FAN:
+		return FinalAN
+	case FEN:
+		return FinalEN
+	case FANG:
+		return FinalANG
+	case FENG:
+		return FinalENG
+	case FU:
+		return FinalU
+	case DA:
+		return FinalA
+	case DE:
+		return FinalE
+	case DAI:
+		return FinalAI
+	case DEI:
+		return FinalEI
+	case DAO:
+		return FinalAO
+	case DOU:
+		return FinalOU
+	case DAN:
+		return FinalAN
+	case DEN:
+		return FinalEN
+	case DANG:
+		return FinalANG
+	case DENG:
+		return FinalENG
+	case DONG:
+		return FinalONG
+	case DI:
+		return FinalI
+	case DIAO:
+		return FinalIAO
+	case DIE:
+		return FinalIE
+	case DIU:
+		return FinalIU
+	case DIAN:
+		return FinalIAN
+	case DING:
+		return FinalING
+	case DU:
+		return FinalU
+	case DUO:
+		return FinalUO
+	case DUI:
+		return FinalUI
+	case DUAN:
+		return FinalUAN
+	case DUN:
+		return FinalUN
+	case TA:
+		return FinalA
+	case TE:
+		return FinalE
+	case TAI:
+		return FinalAI
+	case TEI:
+		return FinalEI
+	case TAO:
+		return FinalAO
+	case TOU:
+		return FinalOU
+	case TAN:
+		return FinalAN
+	case TANG:
+		return FinalANG
+	case TENG:
+		return FinalENG
+	case TONG:
+		return FinalONG
+	case TI:
+		return FinalI
+	case TIAO:
+		return FinalIAO
+	case TIE:
+		return FinalIE
+	case TIAN:
+		return FinalIAN
+	case TING:
+		return FinalING
+	case TU:
+		return FinalU
+	case TUO:
+		return FinalUO
+	case TUI:
+		return FinalUI
+	case TUAN:
+		return FinalUAN
+	case TUN:
+		return FinalUN
+	case NA:
+		return FinalA
+	case NE:
+		return FinalE
+	case NAI:
+		return FinalAI
+	case NEI:
+		return FinalEI
+	case NAO:
+		return FinalAO
+	case NOU:
+		return FinalOU
+	case NAN:
+		return FinalAN
+	case NEN:
+		return FinalEN
+	case NANG:
+		return FinalANG
+	case NENG:
+		return FinalENG
+	case NONG:
+		return FinalONG
+	case NI:
+		return FinalI
+	case NIAO:
+		return FinalIAO
+	case NIE:
+		return FinalIE
+	case NIU:
+		return FinalIU
+	case NIAN:
+		return FinalIAN
+	case NIN:
+		return FinalIN
+	case NIANG:
+		return FinalIANG
+	case NING:
+		return FinalING
+	case NU:
+		return FinalU
+	case NUO:
+		return FinalUO
+	case NUAN:
+		return FinalUAN
+	case NÜ:
+		return FinalÜ
+	case NÜE:
+		return FinalÜE
+	case LA:
+		return FinalA
+	case LE:
+		return FinalE
+	case LAI:
+		return FinalAI
+	case LEI:
+		return FinalEI
+	case LAO:
+		return FinalAO
+	case LOU:
+		return FinalOU
+	case LAN:
+		return FinalAN
+	case LANG:
+		return FinalANG
+	case LENG:
+		return FinalENG
+	case LONG:
+		return FinalONG
+	case LI:
+		return FinalI
+	case LIA:
+		return FinalIA
+	case LIAO:
+		return FinalIAO
+	case LIE:
+		return FinalIE
+	case LIU:
+		return FinalIU
+	case LIAN:
+		return FinalIAN
+	case LIN:
+		return FinalIN
+	case LIANG:
+		return FinalIANG
+	case LING:
+		return FinalING
+	case LU:
+		return FinalU
+	case LUO:
+		return FinalUO
+	case LUAN:
+		return FinalUAN
+	case LUN:
+		return FinalUN
+	case LÜ:
+		return FinalÜ
+	case LÜE:
+		return FinalÜE
+	case GA:
+		return FinalA
+	case GE:
+		return FinalE
+	case GAI:
+		return FinalAI
+	case GEI:
+		return FinalEI
+	case GAO:
+		return FinalAO
+	case GOU:
+		return FinalOU
+	case GAN:
+		return FinalAN
+	case GEN:
+		return FinalEN
+	case GANG:
+		return FinalANG
+	case GENG:
+		return FinalENG
+	case GONG:
+		return FinalONG
+	case GU:
+		return FinalU
+	case GUA:
+		return FinalUA
+	case GUO:
+		return FinalUO
+	case GUAI:
+		return FinalUAI
+	case GUI:
+		return FinalUI
+	case GUAN:
+		return FinalUAN
+	case GUN:
+		return FinalUN
+	case GUANG:
+		return FinalUANG
+	case KA:
+		return FinalA
+	case KE:
+		return FinalE
+	case KAI:
+		return FinalAI
+	case KEI:
+		return FinalEI
+	case KAO:
+		return FinalAO
+	case KOU:
+		return FinalOU
+	case KAN:
+		return FinalAN
+	case KEN:
+		return FinalEN
+	case KANG:
+		return FinalANG
+	case KENG:
+		return FinalENG
+	case KONG:
+		return FinalONG
+	case KU:
+		return FinalU
+	case KUA:
+		return FinalUA
+	case KUO:
+		return FinalUO
+	case KUAI:
+		return FinalUAI
+	case KUI:
+		return FinalUI
+	case KUAN:
+		return FinalUAN
+	case KUN:
+		return FinalUN
+	case KUANG:
+		return FinalUANG
+	case HA:
+		return FinalA
+	case HE:
+		return FinalE
+	case HAI:
+		return FinalAI
+	case HEI:
+		return FinalEI
+	case HAO:
+		return FinalAO
+	case HOU:
+		return FinalOU
+	case HAN:
+		return FinalAN
+	case HEN:
+		return FinalEN
+	case HANG:
+		return FinalANG
+	case HENG:
+		return FinalENG
+	case HONG:
+		return FinalONG
+	case HU:
+		return FinalU
+	case HUA:
+		return FinalUA
+	case HUO:
+		return FinalUO
+	case HUAI:
+		return FinalUAI
+	case HUI:
+		return FinalUI
+	case HUAN:
+		return FinalUAN
+	case HUN:
+		return FinalUN
+	case HUANG:
+		return FinalUANG
+	case ZA:
+		return FinalA
+	case ZE:
+		return FinalE
+	case ZI:
+		return FinalI
+	case ZAI:
+		return FinalAI
+	case ZEI:
+		return FinalEI
+	case ZAO:
+		return FinalAO
+	case ZOU:
+		return FinalOU
+	case ZAN:
+		return FinalAN
+	case ZEN:
+		return FinalEN
+	case ZANG:
+		return FinalANG
+	case ZENG:
+		return FinalENG
+	case ZONG:
+		return FinalONG
+	case ZU:
+		return FinalU
+	case ZUO:
+		return FinalUO
+	case ZUI:
+		return FinalUI
+	case ZUAN:
+		return FinalUAN
+	case ZUN:
+		return FinalUN
+	case CA:
+		return FinalA
+	case CE:
+		return FinalE
+	case CI:
+		return FinalI
+	case CAI:
+		return FinalAI
+	case CAO:
+		return FinalAO
+	case COU:
+		return FinalOU
+	case CAN:
+		return FinalAN
+	case CEN:
+		return FinalEN
+	case CANG:
+		return FinalANG
+	case CENG:
+		return FinalENG
+	case CONG:
+		return FinalONG
+	case CU:
+		return FinalU
+	case CUO:
+		return FinalUO
+	case CUI:
+		return FinalUI
+	case CUAN:
+		return FinalUAN
+	case CUN:
+		return FinalUN
+	case SA:
+		return FinalA
+	case SE:
+		return FinalE
+	case SI:
+		return FinalI
+	case SAI:
+		return FinalAI
+	case SAO:
+		return FinalAO
+	case SOU:
+		return FinalOU
+	case SAN:
+		return FinalAN
+	case SEN:
+		return FinalEN
+	case SANG:
+		return FinalANG
+	case SENG:
+		return FinalENG
+	case SONG:
+		return FinalONG
+	case SU:
+		return FinalU
+	case SUO:
+		return FinalUO
+	case SUI:
+		return FinalUI
+	case SUAN:
+		return FinalUAN
+	case SUN:
+		return FinalUN
+	case ZHA:
+		return FinalA
+	case ZHE:
+		return FinalE
+	case ZHI:
+		return FinalI
+	case ZHAI:
+		return FinalAI
+	case ZHEI:
+		return FinalEI
+	case ZHAO:
+		return FinalAO
+	case ZHOU:
+		return FinalOU
+	case ZHAN:
+		return FinalAN
+	case ZHEN:
+		return FinalEN
+	case ZHANG:
+		return FinalANG
+	case ZHENG:
+		return FinalENG
+	case ZHONG:
+		return FinalONG
+	case ZHU:
+		return FinalU
+	case ZHUA:
+		return FinalUA
+	case ZHUO:
+		return FinalUO
+	case ZHUAI:
+		return FinalUAI
+	case ZHUI:
+		return FinalUI
+	case ZHUAN:
+		return FinalUAN
+	case ZHUN:
+		return FinalUN
+	case ZHUANG:
+		return FinalUANG
+	case CHA:
+		return FinalA
+	case CHE:
+		return FinalE
+	case CHI:
+		return FinalI
+	case CHAI:
+		return FinalAI
+	case CHAO:
+		return FinalAO
+	case CHOU:
+		return FinalOU
+	case CHAN:
+		return FinalAN
+	case CHEN:
+		return FinalEN
+	case CHANG:
+		return FinalANG
+	case CHENG:
+		return FinalENG
+	case CHONG:
+		return FinalONG
+	case CHU:
+		return FinalU
+	case CHUA:
+		return FinalUA
+	case CHUO:
+		return FinalUO
+	case CHUAI:
+		return FinalUAI
+	case CHUI:
+		return FinalUI
+	case CHUAN:
+		return FinalUAN
+	case CHUN:
+		return FinalUN
+	case CHUANG:
+		return FinalUANG
+	case SHA:
+		return FinalA
+	case SHE:
+		return FinalE
+	case SHI:
+		return FinalI
+	case SHAI:
+		return FinalAI
+	case SHEI:
+		return FinalEI
+	case SHAO:
+		return FinalAO
+	case SHOU:
+		return FinalOU
+	case SHAN:
+		return FinalAN
+	case SHEN:
+		return FinalEN
+	case SHANG:
+		return FinalANG
+	case SHENG:
+		return FinalENG
+	case SHU:
+		return FinalU
+	case SHUA:
+		return FinalUA
+	case SHUO:
+		return FinalUO
+	case SHUAI:
+		return FinalUAI
+	case SHUI:
+		return FinalUI
+	case SHUAN:
+		return FinalUAN
+	case SHUN:
+		return FinalUN
+	case SHUANG:
+		return FinalUANG
+	case RE:
+		return FinalE
+	case RI:
+		return FinalI
+	case RAO:
+		return FinalAO
+	case ROU:
+		return FinalOU
+	case RAN:
+		return FinalAN
+	case REN:
+		return FinalEN
+	case RANG:
+		return FinalANG
+	case RENG:
+		return FinalENG
+	case RONG:
+		return FinalONG
+	case RU:
+		return FinalU
+	case RUA:
+		return FinalUA
+	case RUO:
+		return FinalUO
+	case RUI:
+		return FinalUI
+	case RUAN:
+		return FinalUAN
+	case RUN:
+		return FinalUN
+	case JI:
+		return FinalI
+	case JIA:
+		return FinalIA
+	case JIAO:
+		return FinalIAO
+	case JIE:
+		return FinalIE
+	case JIU:
+		return FinalIU
+	case JIAN:
+		return FinalIAN
+	case JIN:
+		return FinalIN
+	case JIANG:
+		return FinalIANG
+	case JING:
+		return FinalING
+	case JIONG:
+		return FinalIONG
+	case JU:
+		return FinalÜ
+	case JUE:
+		return FinalÜE
+	case JUAN:
+		return FinalÜAN
+	case JUN:
+		return FinalÜN
+	case QI:
+		return FinalI
+	case QIA:
+		return FinalIA
+	case QIAO:
+		return FinalIAO
+	case QIE:
+		return FinalIE
+	case QIU:
+		return FinalIU
+	case QIAN:
+		return FinalIAN
+	case QIN:
+		return FinalIN
+	case QIANG:
+		return FinalIANG
+	case QING:
+		return FinalING
+	case QIONG:
+		return FinalIONG
+	case QU:
+		return FinalÜ
+	case QUE:
+		return FinalÜE
+	case QUAN:
+		return FinalÜAN
+	case QUN:
+		return FinalÜN
+	case XI:
+		return FinalI
+	case XIA:
+		return FinalIA
+	case XIAO:
+		return FinalIAO
+	case XIE:
+		return FinalIE
+	case XIU:
+		return FinalIU
+	case XIAN:
+		return FinalIAN
+	case XIN:
+		return FinalIN
+	case XIANG:
+		return FinalIANG
+	case XING:
+		return FinalING
+	case XIONG:
+		return FinalIONG
+	case XU:
+		return FinalÜ
+	case XUE:
+		return FinalÜE
+	case XUAN:
+		return FinalÜAN
+	case XUN:
+		return FinalÜN
+	case FIAO:
+		return FinalIAO
+	case N:
+		return FinalN
+	case NG:
+		return FinalNG
+	case M:
+		return FinalM
+	case YO:
+		return FinalIO
+	case HM:
+		return FinalM
+	case LO:
+		return FinalO
+	case EI:
+		return FinalEI
+	case NUN:
+		return FinalUN
+	case CEI:
+		return FinalEI
+	case WONG:
+		return FinalONG
+	case DIN:
+		return FinalIN
+	case BIANG:
+		return FinalIANG
+	case R:
+		return FinalR
+	default:
+		return 0
+	}
+}
+
+// Compose returns the sound made up of an initial and a final, or
+// false if there is none.
+func Compose(i Initial, f Final) (Sound, bool) {
+	switch i {
+	case NoInitial:
+		switch f {
+		case FinalA:
+			return A, true
+		case FinalO:
+			return O, true
+		case FinalE:
+			return E, true
+		case FinalER:
+			return ER, true
+		case FinalAI:
+			return AI, true
+		case FinalAO:
+			return AO, true
+		case FinalOU:
+			return OU, true
+		case FinalAN:
+			return AN, true
+		case FinalEN:
+			return EN, true
+		case FinalANG:
+			return ANG, true
+		case FinalENG:
+			return ENG, true
+		case FinalI:
+			return YI, true
+		case FinalIA:
+			return YA, true
+		case FinalIAO:
+			return YAO, true
+		case FinalIE:
+			return YE, true
+		case FinalIU:
+			return YOU, true
+		case FinalIAN:
+			return YAN, true
+		case FinalIN:
+			return YIN, true
+		case FinalIANG:
+			return YANG, true
+		case FinalING:
+			return YING, true
+		case FinalIONG:
+			return YONG, true
+		case FinalU:
+			return WU, true
+		case FinalUA:
+			return WA, true
+		case FinalUO:
+			return WO, true
+		case FinalUAI:
+			return WAI, true
+		case FinalUI:
+			return WEI, true
+		case FinalUAN:
+			return WAN, true
+		case FinalUN:
+			return WEN, true
+		case FinalUANG:
+			return WANG, true
+		case FinalUENG:
+			return WENG, true
+		case FinalÜ:
+			return YU, true
+		case FinalÜE:
+			return YUE, true
+		case FinalÜAN:
+			return YUAN, true
+		case FinalÜN:
+			return YUN, true
+		case FinalEI:
+			return EI, true
+		case FinalONG:
+			return WONG, true
+		case FinalN:
+			return N, true
+		case FinalNG:
+			return NG, true
+		case FinalM:
+			return M, true
+		case FinalIO:
+			return YO, true
+		case FinalR:
+			return R, true
+		}
+	case InitialZH:
+		switch f {
+		case FinalA:
+			return ZHA, true
+		case FinalE:
+			return ZHE, true
+		case FinalAI:
+			return ZHAI, true
+		case FinalAO:
+			return ZHAO, true
+		case FinalOU:
+			return ZHOU, true
+		case FinalAN:
+			return ZHAN, true
+		case FinalEN:
+			return ZHEN, true
+		case FinalANG:
+			return ZHANG, true
+		case FinalENG:
+			return ZHENG, true
+		case FinalI:
+			return ZHI, true
+		case FinalU:
+			return ZHU, true
+		case FinalUA:
+			return ZHUA, true
+		case FinalUO:
+			return ZHUO, true
+		case FinalUAI:
+			return ZHUAI, true
+		case FinalUI:
+			return ZHUI, true
+		case FinalUAN:
+			return ZHUAN, true
+		case FinalUN:
+			return ZHUN, true
+		case FinalUANG:
+			return ZHUANG, true
+		case FinalEI:
+			return ZHEI, true
+		case FinalONG:
+			return ZHONG, true
+		}
+	case InitialCH:
+		switch f {
+		case FinalA:
+			return CHA, true
+		case FinalE:
+			return CHE, true
+		case FinalAI:
+			return CHAI, true
+		case FinalAO:
+			return CHAO, true
+		case FinalOU:
+			return CHOU, true
+		case FinalAN:
+			return CHAN, true
+		case FinalEN:
+			return CHEN, true
+		case FinalANG:
+			return CHANG, true
+		case FinalENG:
+			return CHENG, true
+		case FinalI:
+			return CHI, true
+		case FinalU:
+			return CHU, true
+		case FinalUA:
+			return CHUA, true
+		case FinalUO:
+			return CHUO, true
+		case FinalUAI:
+			return CHUAI, true
+		case FinalUI:
+			return CHUI, true
+		case FinalUAN:
+			return CHUAN, true
+		case FinalUN:
+			return CHUN, true
+		case FinalUANG:
+			return CHUANG, true
+		case FinalONG:
+			return CHONG, true
+		}
+	case InitialSH:
+		switch f {
+		case FinalA:
+			return SHA, true
+		case FinalE:
+			return SHE, true
+		case FinalAI:
+			return SHAI, true
+		case FinalAO:
+			return SHAO, true
+		case FinalOU:
+			return SHOU, true
+		case FinalAN:
+			return SHAN, true
+		case FinalEN:
+			return SHEN, true
+		case FinalANG:
+			return SHANG, true
+		case FinalENG:
+			return SHENG, true
+		case FinalI:
+			return SHI, true
+		case FinalU:
+			return SHU, true
+		case FinalUA:
+			return SHUA, true
+		case FinalUO:
+			return SHUO, true
+		case FinalUAI:
+			return SHUAI, true
+		case FinalUI:
+			return SHUI, true
+		case FinalUAN:
+			return SHUAN, true
+		case FinalUN:
+			return SHUN, true
+		case FinalUANG:
+			return SHUANG, true
+		case FinalEI:
+			return SHEI, true
+		}
+	case InitialB:
+		switch f {
+		case FinalA:
+			return BA, true
+		case FinalO:
+			return BO, true
+		case FinalAI:
+			return BAI, true
+		case FinalAO:
+			return BAO, true
+		case FinalAN:
+			return BAN, true
+		case FinalEN:
+			return BEN, true
+		case FinalANG:
+			return BANG, true
+		case FinalENG:
+			return BENG, true
+		case FinalI:
+			return BI, true
+		case FinalIAO:
+			return BIAO, true
+		case FinalIE:
+			return BIE, true
+		case FinalIAN:
+			return BIAN, true
+		case FinalIN:
+			return BIN, true
+		case FinalIANG:
+			return BIANG, true
+		case FinalING:
+			return BING, true
+		case FinalU:
+			return BU, true
+		case FinalEI:
+			return BEI, true
+		}
+	case InitialP:
+		switch f {
+		case FinalA:
+			return PA, true
+		case FinalO:
+			return PO, true
+		case FinalAI:
+			return PAI, true
+		case FinalAO:
+			return PAO, true
+		case FinalOU:
+			return POU, true
+		case FinalAN:
+			return PAN, true
+		case FinalEN:
+			return PEN, true
+		case FinalANG:
+			return PANG, true
+		case FinalENG:
+			return PENG, true
+		case FinalI:
+			return PI, true
+		case FinalIAO:
+			return PIAO, true
+		case FinalIE:
+			return PIE, true
+		case FinalIAN:
+			return PIAN, true
+		case FinalIN:
+			return PIN, true
+		case FinalING:
+			return PING, true
+		case FinalU:
+			return PU, true
+		case FinalEI:
+			return PEI, true
+		}
+	case InitialM:
+		switch f {
+		case FinalA:
+			return MA, true
+		case FinalO:
+			return MO, true
+		case FinalE:
+			return ME, true
+		case FinalAI:
+			return MAI, true
+		case FinalAO:
+			return MAO, true
+		case FinalOU:
+			return MOU, true
+		case FinalAN:
+			return MAN, true
+		case FinalEN:
+			return MEN, true
+		case FinalANG:
+			return MANG, true
+		case FinalENG:
+			return MENG, true
+		case FinalI:
+			return MI, true
+		case FinalIAO:
+			return MIAO, true
+		case FinalIE:
+			return MIE, true
+		case FinalIU:
+			return MIU, true
+		case FinalIAN:
+			return MIAN, true
+		case FinalIN:
+			return MIN, true
+		case FinalING:
+			return MING, true
+		case FinalU:
+			return MU, true
+		case FinalEI:
+			return MEI, true
+		}
+	case InitialF:
+		switch f {
+		case FinalA:
+			return FA, true
+		case FinalO:
+			return FO, true
+		case FinalOU:
+			return FOU, true
+		case FinalAN:
+			return FAN, true
+		case FinalEN:
+			return FEN, true
+		case FinalANG:
+			return FANG, true
+		case FinalENG:
+			return FENG, true
+		case FinalIAO:
+			return FIAO, true
+		case FinalU:
+			return FU, true
+		case FinalEI:
+			return FEI, true
+		}
+	case InitialD:
+		switch f {
+		case FinalA:
+			return DA, true
+		case FinalE:
+			return DE, true
+		case FinalAI:
+			return DAI, true
+		case FinalAO:
+			return DAO, true
+		case FinalOU:
+			return DOU, true
+		case FinalAN:
+			return DAN, true
+		case FinalEN:
+			return DEN, true
+		case FinalANG:
+			return DANG, true
+		case FinalENG:
+			return DENG, true
+		case FinalI:
+			return DI, true
+		case FinalIAO:
+			return DIAO, true
+		case FinalIE:
+			return DIE, true
+		case FinalIU:
+			return DIU, true
+		case FinalIAN:
+			return DIAN, true
+		case FinalIN:
+			return DIN, true
+		case FinalING:
+			return DING, true
+		case FinalU:
+			return DU, true
+		case FinalUO:
+			return DUO, true
+		case FinalUI:
+			return DUI, true
+		case FinalUAN:
+			return DUAN, true
+		case FinalUN:
+			return DUN, true
+		case FinalEI:
+			return DEI, true
+		case FinalONG:
+			return DONG, true
+		}
+	case InitialT:
+		switch f {
+		case FinalA:
+			return TA, true
+		case FinalE:
+			return TE, true
+		case FinalAI:
+			return TAI, true
+		case FinalAO:
+			return TAO, true
+		case FinalOU:
+			return TOU, true
+		case FinalAN:
+			return TAN, true
+		case FinalANG:
+			return TANG, true
+		case FinalENG:
+			return TENG, true
+		case FinalI:
+			return TI, true
+		case FinalIAO:
+			return TIAO, true
+		case FinalIE:
+			return TIE, true
+		case FinalIAN:
+			return TIAN, true
+		case FinalING:
+			return TING, true
+		case FinalU:
+			return TU, true
+		case FinalUO:
+			return TUO, true
+		case FinalUI:
+			return TUI, true
+		case FinalUAN:
+			return TUAN, true
+		case FinalUN:
+			return TUN, true
+		case FinalEI:
+			return TEI, true
+		case FinalONG:
+			return TONG, true
+		}
+	case InitialN:
+		switch f {
+		case FinalA:
+			return NA, true
+		case FinalE:
+			return NE, true
+		case FinalAI:
+			return NAI, true
+		case FinalAO:
+			return NAO, true
+		case FinalOU:
+			return NOU, true
+		case FinalAN:
+			return NAN, true
+		case FinalEN:
+			return NEN, true
+		case FinalANG:
+			return NANG, true
+		case FinalENG:
+			return NENG, true
+		case FinalI:
+			return NI, true
+		case FinalIAO:
+			return NIAO, true
+		case FinalIE:
+			return NIE, true
+		case FinalIU:
+			return NIU, true
+		case FinalIAN:
+			return NIAN, true
+		case FinalIN:
+			return NIN, true
+		case FinalIANG:
+			return NIANG, true
+		case FinalING:
+			return NING, true
+		case FinalU:
+			return NU, true
+		case FinalUO:
+			return NUO, true
+		case FinalUAN:
+			return NUAN, true
+		case FinalUN:
+			return NUN, true
+		case FinalÜ:
+			return NÜ, true
+		case FinalÜE:
+			return NÜE, true
+		case FinalEI:
+			return NEI, true
+		case FinalONG:
+			return NONG, true
+		}
+	case InitialL:
+		switch f {
+		case FinalA:
+			return LA, true
+		case FinalO:
+			return LO, true
+		case FinalE:
+			return LE, true
+		case FinalAI:
+			return LAI, true
+		case FinalAO:
+			return LAO, true
+		case FinalOU:
+			return LOU, true
+		case FinalAN:
+			return LAN, true
+		case FinalANG:
+			return LANG, true
+		case FinalENG:
+			return LENG, true
+		case FinalI:
+			return LI, true
+		case FinalIA:
+			return LIA, true
+		case FinalIAO:
+			return LIAO, true
+		case FinalIE:
+			return LIE, true
+		case FinalIU:
+			return LIU, true
+		case FinalIAN:
+			return LIAN, true
+		case FinalIN:
+			return LIN, true
+		case FinalIANG:
+			return LIANG, true
+		case FinalING:
+			return LING, true
+		case FinalU:
+			return LU, true
+		case FinalUO:
+			return LUO, true
+		case FinalUAN:
+			return LUAN, true
+		case FinalUN:
+			return LUN, true
+		case FinalÜ:
+			return LÜ, true
+		case FinalÜE:
+			return LÜE, true
+		case FinalEI:
+			return LEI, true
+		case FinalONG:
+			return LONG, true
+		}
+	case InitialG:
+		switch f {
+		case FinalA:
+			return GA, true
+		case FinalE:
+			return GE, true
+		case FinalAI:
+			return GAI, true
+		case FinalAO:
+			return GAO, true
+		case FinalOU:
+			return GOU, true
+		case FinalAN:
+			return GAN, true
+		case FinalEN:
+			return GEN, true
+		case FinalANG:
+			return GANG, true
+		case FinalENG:
+			return GENG, true
+		case FinalU:
+			return GU, true
+		case FinalUA:
+			return GUA, true
+		case FinalUO:
+			return GUO, true
+		case FinalUAI:
+			return GUAI, true
+		case FinalUI:
+			return GUI, true
+		case FinalUAN:
+			return GUAN, true
+		case FinalUN:
+			return GUN, true
+		case FinalUANG:
+			return GUANG, true
+		case FinalEI:
+			return GEI, true
+		case FinalONG:
+			return GONG, true
+		}
+	case InitialK:
+		switch f {
+		case FinalA:
+			return KA, true
+		case FinalE:
+			return KE, true
+		case FinalAI:
+			return KAI, true
+		case FinalAO:
+			return KAO, true
+		case FinalOU:
+			return KOU, true
+		case FinalAN:
+			return KAN, true
+		case FinalEN:
+			return KEN, true
+		case FinalANG:
+			return KANG, true
+		case FinalENG:
+			return KENG, true
+		case FinalU:
+			return KU, true
+		case FinalUA:
+			return KUA, true
+		case FinalUO:
+			return KUO, true
+		case FinalUAI:
+			return KUAI, true
+		case FinalUI:
+			return KUI, true
+		case FinalUAN:
+			return KUAN, true
+		case FinalUN:
+			return KUN, true
+		case FinalUANG:
+			return KUANG, true
+		case FinalEI:
+			return KEI, true
+		case FinalONG:
+			return KONG, true
+		}
+	case InitialH:
+		switch f {
+		case FinalA:
+			return HA, true
+		case FinalE:
+			return HE, true
+		case FinalAI:
+			return HAI, true
+		case FinalAO:
+			return HAO, true
+		case FinalOU:
+			return HOU, true
+		case FinalAN:
+			return HAN, true
+		case FinalEN:
+			return HEN, true
+		case FinalANG:
+			return HANG, true
+		case FinalENG:
+			return HENG, true
+		case FinalU:
+			return HU, true
+		case FinalUA:
+			return HUA, true
+		case FinalUO:
+			return HUO, true
+		case FinalUAI:
+			return HUAI, true
+		case FinalUI:
+			return HUI, true
+		case FinalUAN:
+			return HUAN, true
+		case FinalUN:
+			return HUN, true
+		case FinalUANG:
+			return HUANG, true
+		case FinalEI:
+			return HEI, true
+		case FinalONG:
+			return HONG, true
+		case FinalM:
+			return HM, true
+		}
+	case InitialJ:
+		switch f {
+		case FinalI:
+			return JI, true
+		case FinalIA:
+			return JIA, true
+		case FinalIAO:
+			return JIAO, true
+		case FinalIE:
+			return JIE, true
+		case FinalIU:
+			return JIU, true
+		case FinalIAN:
+			return JIAN, true
+		case FinalIN:
+			return JIN, true
+		case FinalIANG:
+			return JIANG, true
+		case FinalING:
+			return JING, true
+		case FinalIONG:
+			return JIONG, true
+		case FinalÜ:
+			return JU, true
+		case FinalÜE:
+			return JUE, true
+		case FinalÜAN:
+			return JUAN, true
+		case FinalÜN:
+			return JUN, true
+		}
+	case InitialQ:
+		switch f {
+		case FinalI:
+			return QI, true
+		case FinalIA:
+			return QIA, true
+		case FinalIAO:
+			return QIAO, true
+		case FinalIE:
+			return QIE, true
+		case FinalIU:
+			return QIU, true
+		case FinalIAN:
+			return QIAN, true
+		case FinalIN:
+			return QIN, true
+		case FinalIANG:
+			return QIANG, true
+		case FinalING:
+			return QING, true
+		case FinalIONG:
+			return QIONG, true
+		case FinalÜ:
+			return QU, true
+		case FinalÜE:
+			return QUE, true
+		case FinalÜAN:
+			return QUAN, true
+		case FinalÜN:
+			return QUN, true
+		}
+	case InitialX:
+		switch f {
+		case FinalI:
+			return XI, true
+		case FinalIA:
+			return XIA, true
+		case FinalIAO:
+			return XIAO, true
+		case FinalIE:
+			return XIE, true
+		case FinalIU:
+			return XIU, true
+		case FinalIAN:
+			return XIAN, true
+		case FinalIN:
+			return XIN, true
+		case FinalIANG:
+			return XIANG, true
+		case FinalING:
+			return XING, true
+		case FinalIONG:
+			return XIONG, true
+		case FinalÜ:
+			return XU, true
+		case FinalÜE:
+			return XUE, true
+		case FinalÜAN:
+			return XUAN, true
+		case FinalÜN:
+			return XUN, true
+		}
+	case InitialR:
+		switch f {
+		case FinalE:
+			return RE, true
+		case FinalAO:
+			return RAO, true
+		case FinalOU:
+			return ROU, true
+		case FinalAN:
+			return RAN, true
+		case FinalEN:
+			return REN, true
+		case FinalANG:
+			return RANG, true
+		case FinalENG:
+			return RENG, true
+		case FinalI:
+			return RI, true
+		case FinalU:
+			return RU, true
+		case FinalUA:
+			return RUA, true
+		case FinalUO:
+			return RUO, true
+		case FinalUI:
+			return RUI, true
+		case FinalUAN:
+			return RUAN, true
+		case FinalUN:
+			return RUN, true
+		case FinalONG:
+			return RONG, true
+		}
+	case InitialZ:
+		switch f {
+		case FinalA:
+			return ZA, true
+		case FinalE:
+			return ZE, true
+		case FinalAI:
+			return ZAI, true
+		case FinalAO:
+			return ZAO, true
+		case FinalOU:
+			return ZOU, true
+		case FinalAN:
+			return ZAN, true
+		case FinalEN:
+			return ZEN, true
+		case FinalANG:
+			return ZANG, true
+		case FinalENG:
+			return ZENG, true
+		case FinalI:
+			return ZI, true
+		case FinalU:
+			return ZU, true
+		case FinalUO:
+			return ZUO, true
+		case FinalUI:
+			return ZUI, true
+		case FinalUAN:
+			return ZUAN, true
+		case FinalUN:
+			return ZUN, true
+		case FinalEI:
+			return ZEI, true
+		case FinalONG:
+			return ZONG, true
+		}
+	case InitialC:
+		switch f {
+		case FinalA:
+			return CA, true
+		case FinalE:
+			return CE, true
+		case FinalAI:
+			return CAI, true
+		case FinalAO:
+			return CAO, true
+		case FinalOU:
+			return COU, true
+		case FinalAN:
+			return CAN, true
+		case FinalEN:
+			return CEN, true
+		case FinalANG:
+			return CANG, true
+		case FinalENG:
+			return CENG, true
+		case FinalI:
+			return CI, true
+		case FinalU:
+			return CU, true
+		case FinalUO:
+			return CUO, true
+		case FinalUI:
+			return CUI, true
+		case FinalUAN:
+			return CUAN, true
+		case FinalUN:
+			return CUN, true
+		case FinalEI:
+			return CEI, true
+		case FinalONG:
+			return CONG, true
+		}
+	case InitialS:
+		switch f {
+		case FinalA:
+			return SA, true
+		case FinalE:
+			return SE, true
+		case FinalAI:
+			return SAI, true
+		case FinalAO:
+			return SAO, true
+		case FinalOU:
+			return SOU, true
+		case FinalAN:
+			return SAN, true
+		case FinalEN:
+			return SEN, true
+		case FinalANG:
+			return SANG, true
+		case FinalENG:
+			return SENG, true
+		case FinalI:
+			return SI, true
+		case FinalU:
+			return SU, true
+		case FinalUO:
+			return SUO, true
+		case FinalUI:
+			return SUI, true
+		case FinalUAN:
+			return SUAN, true
+		case FinalUN:
+			return SUN, true
+		case FinalONG:
+			return SONG, true
+		}
+	}
+	return 0, false
+}
+
+func (p Sound) zhuyin() string {
+	switch p {
+	case A:
+		return "ㄚ"
+	case O:
+		return "ㄛ"
+	case E:
+		return "ㄜ"
+	case ER:
+		return "ㄦ"
+	case AI:
+		return "ㄞ"
+	case AO:
+		return "ㄠ"
+	case OU:
+		return "ㄡ"
+	case AN:
+		return "ㄢ"
+	case EN:
+		return "ㄣ"
+	case ANG:
+		return "ㄤ"
+	case ENG:
+		return "ㄥ"
+	case YI:
+		return "ㄧ"
+	case YA:
+		return "ㄧㄚ"
+	case YAO:
+		return "ㄧㄠ"
+	case YE:
+		return "ㄧㄝ"
+	case YOU:
+		return "ㄧㄡ"
+	case YAN:
+		return "ㄧㄢ"
+	case YIN:
+		return "ㄧㄣ"
+	case YANG:
+		return "ㄧㄤ"
+	case YING:
+		return "ㄧㄥ"
+	case YONG:
+		return "ㄩㄥ"
+	case WU:
+		return "ㄨ"
+	case WA:
+		return "ㄨㄚ"
+	case WO:
+		return "ㄨㄛ"
+	case WAI:
+		return "ㄨㄞ"
+	case WEI:
+		return "ㄨㄟ"
+	case WAN:
+		return "ㄨㄢ"
+	case WEN:
+		return "ㄨㄣ"
+	case WANG:
+		return "ㄨㄤ"
+	case WENG:
+		return "ㄨㄥ"
+	case YU:
+		return "ㄩ"
+	case YUE:
+		return "ㄩㄝ"
+	case YUAN:
+		return "ㄩㄢ"
+	case YUN:
+		return "ㄩㄣ"
+	case BA:
+		return "ㄅㄚ"
+	case BO:
+		return "ㄅㄛ"
+	case BAI:
+		return "ㄅㄞ"
+	case BEI:
+		return "ㄅㄟ"
+	case BAO:
+		return "ㄅㄠ"
+	case BAN:
+		return "ㄅㄢ"
+	case BEN:
+		return "ㄅㄣ"
+	case BANG:
+		return "ㄅㄤ"
+	case BENG:
+		return "ㄅㄥ"
+	case BI:
+		return "ㄅㄧ"
+	case BIAO:
+		return "ㄅㄧㄠ"
+	case BIE:
+		return "ㄅㄧㄝ"
+	case BIAN:
+		return "ㄅㄧㄢ"
+	case BIN:
+		return "ㄅㄧㄣ"
+	case BING:
+		return "ㄅㄧㄥ"
+	case BU:
+		return "ㄅㄨ"
+	case PA:
+		return "ㄆㄚ"
+	case PO:
+		return "ㄆㄛ"
+	case PAI:
+		return "ㄆㄞ"
+	case PEI:
+		return "ㄆㄟ"
+	case PAO:
+		return "ㄆㄠ"
+	case POU:
+		return "ㄆㄡ"
+	case PAN:
+		return "ㄆㄢ"
+	case PEN:
+		return "ㄆㄣ"
+	case PANG:
+		return "ㄆㄤ"
+	case PENG:
+		return "ㄆㄥ"
+	case PI:
+		return "ㄆㄧ"
+	case PIAO:
+		return "ㄆㄧㄠ"
+	case PIE:
+		return "ㄆㄧㄝ"
+	case PIAN:
+		return "ㄆㄧㄢ"
+	case PIN:
+		return "ㄆㄧㄣ"
+	case PING:
+		return "ㄆㄧㄥ"
+	case PU:
+		return "ㄆㄨ"
+	case MA:
+		return "ㄇㄚ"
+	case MO:
+		return "ㄇㄛ"
+	case ME:
+		return "ㄇㄜ"
+	case MAI:
+		return "ㄇㄞ"
+	case MEI:
+		return "ㄇㄟ"
+	case MAO:
+		return "ㄇㄠ"
+	case MOU:
+		return "ㄇㄡ"
+	case MAN:
+		return "ㄇㄢ"
+	case MEN:
+		return "ㄇㄣ"
+	case MANG:
+		return "ㄇㄤ"
+	case MENG:
+		return "ㄇㄥ"
+	case MI:
+		return "ㄇㄧ"
+	case MIAO:
+		return "ㄇㄧㄠ"
+	case MIE:
+		return "ㄇㄧㄝ"
+	case MIU:
+		return "ㄇㄧㄡ"
+	case MIAN:
+		return "ㄇㄧㄢ"
+	case MIN:
+		return "ㄇㄧㄣ"
+	case MING:
+		return "ㄇㄧㄥ"
+	case MU:
+		return "ㄇㄨ"
+	case FA:
+		return "ㄈㄚ"
+	case FO:
+		return "ㄈㄛ"
+	case FEI:
+		return "ㄈㄟ"
+	case FOU:
+		return "ㄈㄡ"
+	case FAN:
+		return "ㄈㄢ"
+	case FEN:
+		return "ㄈㄣ"
+	case FANG:
+		return "ㄈㄤ"
+	case FENG:
+		return "ㄈㄥ"
+	case FU:
+		return "ㄈㄨ"
+	case DA:
+		return "ㄉㄚ"
+	case DE:
+		return "ㄉㄜ"
+	case DAI:
+		return "ㄉㄞ"
+	case DEI:
+		return "ㄉㄟ"
+	case DAO:
+		return "ㄉㄠ"
+	case DOU:
+		return "ㄉㄡ"
+	case DAN:
+		return "ㄉㄢ"
+	case DEN:
+		return "ㄉㄣ"
+	case DANG:
+		return "ㄉㄤ"
+	case DENG:
+		return "ㄉㄥ"
+	case DONG:
+		return "ㄉㄨㄥ"
+	case DI:
+		return "ㄉㄧ"
+	case DIAO:
+		return "ㄉㄧㄠ"
+	case DIE:
+		return "ㄉㄧㄝ"
+	case DIU:
+		return "ㄉㄧㄡ"
+	case DIAN:
+		return "ㄉㄧㄢ"
+	case DING:
+		return "ㄉㄧㄥ"
+	case DU:
+		return "ㄉㄨ"
+	case DUO:
+		return "ㄉㄨㄛ"
+	case DUI:
+		return "ㄉㄨㄟ"
+	case DUAN:
+		return "ㄉㄨㄢ"
+	case DUN:
+		return "ㄉㄨㄣ"
+	case TA:
+		return "ㄊㄚ"
+	case TE:
+		return "ㄊㄜ"
+	case TAI:
+		return "ㄊㄞ"
+	case TEI:
+		return "ㄊㄟ"
+	case TAO:
+		return "ㄊㄠ"
+	case TOU:
+		return "ㄊㄡ"
+	case TAN:
+		return "ㄊㄢ"
+	case TANG:
+		return "ㄊㄤ"
+	case TENG:
+		return "ㄊㄥ"
+	case TONG:
+		return "ㄊㄨㄥ"
+	case TI:
+		return "ㄊㄧ"
+	case TIAO:
+		return "ㄊㄧㄠ"
+	case TIE:
+		return "ㄊㄧㄝ"
+	case TIAN:
+		return "ㄊㄧㄢ"
+	case TING:
+		return "ㄊㄧㄥ"
+	case TU:
+		return "ㄊㄨ"
+	case TUO:
+		return "ㄊㄨㄛ"
+	case TUI:
+		return "ㄊㄨㄟ"
+	case TUAN:
+		return "ㄊㄨㄢ"
+	case TUN:
+		return "ㄊㄨㄣ"
+	case NA:
+		return "ㄋㄚ"
+	case NE:
+		return "ㄋㄜ"
+	case NAI:
+		return "ㄋㄞ"
+	case NEI:
+		return "ㄋㄟ"
+	case NAO:
+		return "ㄋㄠ"
+	case NOU:
+		return "ㄋㄡ"
+	case NAN:
+		return "ㄋㄢ"
+	case NEN:
+		return "ㄋㄣ"
+	case NANG:
+		return "ㄋㄤ"
+	case NENG:
+		return "ㄋㄥ"
+	case NONG:
+		return "ㄋㄨㄥ"
+	case NI:
+		return "ㄋㄧ"
+	case NIAO:
+		return "ㄋㄧㄠ"
+	case NIE:
+		return "ㄋㄧㄝ"
+	case NIU:
+		return "ㄋㄧㄡ"
+	case NIAN:
+		return "ㄋㄧㄢ"
+	case NIN:
+		return "ㄋㄧㄣ"
+	case NIANG:
+		return "ㄋㄧㄤ"
+	case NING:
+		return "ㄋㄧㄥ"
+	case NU:
+		return "ㄋㄨ"
+	case NUO:
+		return "ㄋㄨㄛ"
+	case NUAN:
+		return "ㄋㄨㄢ"
+	case NÜ:
+		return "ㄋㄩ"
+	case NÜE:
+		return "ㄋㄩㄝ"
+	case LA:
+		return "ㄌㄚ"
+	case LE:
+		return "ㄌㄜ"
+	case LAI:
+		return "ㄌㄞ"
+	case LEI:
+		return "ㄌㄟ"
+	case LAO:
+		return "ㄌㄠ"
+	case LOU:
+		return "ㄌㄡ"
+	case LAN:
+		return "ㄌㄢ"
+	case LANG:
+		return "ㄌㄤ"
+	case LENG:
+		return "ㄌㄥ"
+	case LONG:
+		return "ㄌㄨㄥ"
+	case LI:
+		return "ㄌㄧ"
+	case LIA:
+		return "ㄌㄧㄚ"
+	case LIAO:
+		return "ㄌㄧㄠ"
+	case LIE:
+		return "ㄌㄧㄝ"
+	case LIU:
+		return "ㄌㄧㄡ"
+	case LIAN:
+		return "ㄌㄧㄢ"
+	case LIN:
+		return "ㄌㄧㄣ"
+	case LIANG:
+		return "ㄌㄧㄤ"
+	case LING:
+		return "ㄌㄧㄥ"
+	case LU:
+		return "ㄌㄨ"
+	case LUO:
+		return "ㄌㄨㄛ"
+	case LUAN:
+		return "ㄌㄨㄢ"
+	case LUN:
+		return "ㄌㄨㄣ"
+	case LÜ:
+		return "ㄌㄩ"
+	case LÜE:
+		return "ㄌㄩㄝ"
+	case GA:
+		return "ㄍㄚ"
+	case GE:
+		return "ㄍㄜ"
+	case GAI:
+		return "ㄍㄞ"
+	case GEI:
+		return "ㄍㄟ"
+	case GAO:
+		return "ㄍㄠ"
+	case GOU:
+		return "ㄍㄡ"
+	case GAN:
+		return "ㄍㄢ"
+	case GEN:
+		return "ㄍㄣ"
+	case GANG:
+		return "ㄍㄤ"
+	case GENG:
+		return "ㄍㄥ"
+	case GONG:
+		return "ㄍㄨㄥ"
+	case GU:
+		return "ㄍㄨ"
+	case GUA:
+		return "ㄍㄨㄚ"
+	case GUO:
+		return "ㄍㄨㄛ"
+	case GUAI:
+		return "ㄍㄨㄞ"
+	case GUI:
+		return "ㄍㄨㄟ"
+	case GUAN:
+		return "ㄍㄨㄢ"
+	case GUN:
+		return "ㄍㄨㄣ"
+	case GUANG:
+		return "ㄍㄨㄤ"
+	case KA:
+		return "ㄎㄚ"
+	case KE:
+		return "ㄎㄜ"
+	case KAI:
+		return "ㄎㄞ"
+	case KEI:
+		return "ㄎㄟ"
+	case KAO:
+		return "ㄎㄠ"
+	case KOU:
+		return "ㄎㄡ"
+	case KAN:
+		return "ㄎㄢ"
+	case KEN:
+		return "ㄎㄣ"
+	case KANG:
+		return "ㄎㄤ"
+	case KENG:
+		return "ㄎㄥ"
+	case KONG:
+		return "ㄎㄨㄥ"
+	case KU:
+		return "ㄎㄨ"
+	case KUA:
+		return "ㄎㄨㄚ"
+	case KUO:
+		return "ㄎㄨㄛ"
+	case KUAI:
+		return "ㄎㄨㄞ"
+	case KUI:
+		return "ㄎㄨㄟ"
+	case KUAN:
+		return "ㄎㄨㄢ"
+	case KUN:
+		return "ㄎㄨㄣ"
+	case KUANG:
+		return "ㄎㄨㄤ"
+	case HA:
+		return "ㄏㄚ"
+	case HE:
+		return "ㄏㄜ"
+	case HAI:
+		return "ㄏㄞ"
+	case HEI:
+		return "ㄏㄟ"
+	case HAO:
+		return "ㄏㄠ"
+	case HOU:
+		return "ㄏㄡ"
+	case HAN:
+		return "ㄏㄢ"
+	case HEN:
+		return "ㄏㄣ"
+	case HANG:
+		return "ㄏㄤ"
+	case HENG:
+		return "ㄏㄥ"
+	case HONG:
+		return "ㄏㄨㄥ"
+	case HU:
+		return "ㄏㄨ"
+	case HUA:
+		return "ㄏㄨㄚ"
+	case HUO:
+		return "ㄏㄨㄛ"
+	case HUAI:
+		return "ㄏㄨㄞ"
+	case HUI:
+		return "ㄏㄨㄟ"
+	case HUAN:
+		return "ㄏㄨㄢ"
+	case HUN:
+		return "ㄏㄨㄣ"
+	case HUANG:
+		return "ㄏㄨㄤ"
+	case ZA:
+		return "ㄗㄚ"
+	case ZE:
+		return "ㄗㄜ"
+	case ZI:
+		return "ㄗ"
+	case ZAI:
+		return "ㄗㄞ"
+	case ZEI:
+		return "ㄗㄟ"
+	case ZAO:
+		return "ㄗㄠ"
+	case ZOU:
+		return "ㄗㄡ"
+	case ZAN:
+		return "ㄗㄢ"
+	case ZEN:
+		return "ㄗㄣ"
+	case ZANG:
+		return "ㄗㄤ"
+	case ZENG:
+		return "ㄗㄥ"
+	case ZONG:
+		return "ㄗㄨㄥ"
+	case ZU:
+		return "ㄗㄨ"
+	case ZUO:
+		return "ㄗㄨㄛ"
+	case ZUI:
+		return "ㄗㄨㄟ"
+	case ZUAN:
+		return "ㄗㄨㄢ"
+	case ZUN:
+		return "ㄗㄨㄣ"
+	case CA:
+		return "ㄘㄚ"
+	case CE:
+		return "ㄘㄜ"
+	case CI:
+		return "ㄘ"
+	case CAI:
+		return "ㄘㄞ"
+	case CAO:
+		return "ㄘㄠ"
+	case COU:
+		return "ㄘㄡ"
+	case CAN:
+		return "ㄘㄢ"
+	case CEN:
+		return "ㄘㄣ"
+	case CANG:
+		return "ㄘㄤ"
+	case CENG:
+		return "ㄘㄥ"
+	case CONG:
+		return "ㄘㄨㄥ"
+	case CU:
+		return "ㄘㄨ"
+	case CUO:
+		return "ㄘㄨㄛ"
+	case CUI:
+		return "ㄘㄨㄟ"
+	case CUAN:
+		return "ㄘㄨㄢ"
+	case CUN:
+		return "ㄘㄨㄣ"
+	case SA:
+		return "ㄙㄚ"
+	case SE:
+		return "ㄙㄜ"
+	case SI:
+		return "ㄙ"
+	case SAI:
+		return "ㄙㄞ"
+	case SAO:
+		return "ㄙㄠ"
+	case SOU:
+		return "ㄙㄡ"
+	case SAN:
+		return "ㄙㄢ"
+	case SEN:
+		return "ㄙㄣ"
+	case SANG:
+		return "ㄙㄤ"
+	case SENG:
+		return "ㄙㄥ"
+	case SONG:
+		return "ㄙㄨㄥ"
+	case SU:
+		return "ㄙㄨ"
+	case SUO:
+		return "ㄙㄨㄛ"
+	case SUI:
+		return "ㄙㄨㄟ"
+	case SUAN:
+		return "ㄙㄨㄢ"
+	case SUN:
+		return "ㄙㄨㄣ"
+	case ZHA:
+		return "ㄓㄚ"
+	case ZHE:
+		return "ㄓㄜ"
+	case ZHI:
+		return "ㄓ"
+	case ZHAI:
+		return "ㄓㄞ"
+	case ZHEI:
+		return "ㄓㄟ"
+	case ZHAO:
+		return "ㄓㄠ"
+	case ZHOU:
+		return "ㄓㄡ"
+	case ZHAN:
+		return "ㄓㄢ"
+	case ZHEN:
+		return "ㄓㄣ"
+	case ZHANG:
+		return "ㄓㄤ"
+	case ZHENG:
+		return "ㄓㄥ"
+	case ZHONG:
+		return "ㄓㄨㄥ"
+	case ZHU:
+		return "ㄓㄨ"
+	case ZHUA:
+		return "ㄓㄨㄚ"
+	case ZHUO:
+		return "ㄓㄨㄛ"
+	case ZHUAI:
+		return "ㄓㄨㄞ"
+	case ZHUI:
+		return "ㄓㄨㄟ"
+	case ZHUAN:
+		return "ㄓㄨㄢ"
+	case ZHUN:
+		return "ㄓㄨㄣ"
+	case ZHUANG:
+		return "ㄓㄨㄤ"
+	case CHA:
+		return "ㄔㄚ"
+	case CHE:
+		return "ㄔㄜ"
+	case CHI:
+		return "ㄔ"
+	case CHAI:
+		return "ㄔㄞ"
+	case CHAO:
+		return "ㄔㄠ"
+	case CHOU:
+		return "ㄔㄡ"
+	case CHAN:
+		return "ㄔㄢ"
+	case CHEN:
+		return "ㄔㄣ"
+	case CHANG:
+		return "ㄔㄤ"
+	case CHENG:
+		return "ㄔㄥ"
+	case CHONG:
+		return "ㄔㄨㄥ"
+	case CHU:
+		return "ㄔㄨ"
+	case CHUA:
+		return "ㄔㄨㄚ"
+	case CHUO:
+		return "ㄔㄨㄛ"
+	case CHUAI:
+		return "ㄔㄨㄞ"
+	case CHUI:
+		return "ㄔㄨㄟ"
+	case CHUAN:
+		return "ㄔㄨㄢ"
+	case CHUN:
+		return "ㄔㄨㄣ"
+	case CHUANG:
+		return "ㄔㄨㄤ"
+	case SHA:
+		return "ㄕㄚ"
+	case SHE:
+		return "ㄕㄜ"
+	case SHI:
+		return "ㄕ"
+	case SHAI:
+		return "ㄕㄞ"
+	case SHEI:
+		return "ㄕㄟ"
+	case SHAO:
+		return "ㄕㄠ"
+	case SHOU:
+		return "ㄕㄡ"
+	case SHAN:
+		return "ㄕㄢ"
+	case SHEN:
+		return "ㄕㄣ"
+	case SHANG:
+		return "ㄕㄤ"
+	case SHENG:
+		return "ㄕㄥ"
+	case SHU:
+		return "ㄕㄨ"
+	case SHUA:
+		return "ㄕㄨㄚ"
+	case SHUO:
+		return "ㄕㄨㄛ"
+	case SHUAI:
+		return "ㄕㄨㄞ"
+	case SHUI:
+		return "ㄕㄨㄟ"
+	case SHUAN:
+		return "ㄕㄨㄢ"
+	case SHUN:
+		return "ㄕㄨㄣ"
+	case SHUANG:
+		return "ㄕㄨㄤ"
+	case RE:
+		return "ㄖㄜ"
+	case RI:
+		return "ㄖ"
+	case RAO:
+		return "ㄖㄠ"
+	case ROU:
+		return "ㄖㄡ"
+	case RAN:
+		return "ㄖㄢ"
+	case REN:
+		return "ㄖㄣ"
+	case RANG:
+		return "ㄖㄤ"
+	case RENG:
+		return "ㄖㄥ"
+	case RONG:
+		return "ㄖㄨㄥ"
+	case RU:
+		return "ㄖㄨ"
+	case RUA:
+		return "ㄖㄨㄚ"
+	case RUO:
+		return "ㄖㄨㄛ"
+	case RUI:
+		return "ㄖㄨㄟ"
+	case RUAN:
+		return "ㄖㄨㄢ"
+	case RUN:
+		return "ㄖㄨㄣ"
+	case JI:
+		return "ㄐㄧ"
+	case JIA:
+		return "ㄐㄧㄚ"
+	case JIAO:
+		return "ㄐㄧㄠ"
+	case JIE:
+		return "ㄐㄧㄝ"
+	case JIU:
+		return "ㄐㄧㄡ"
+	case JIAN:
+		return "ㄐㄧㄢ"
+	case JIN:
+		return "ㄐㄧㄣ"
+	case JIANG:
+		return "ㄐㄧㄤ"
+	case JING:
+		return "ㄐㄧㄥ"
+	case JIONG:
+		return "ㄐㄩㄥ"
+	case JU:
+		return "ㄐㄩ"
+	case JUE:
+		return "ㄐㄩㄝ"
+	case JUAN:
+		return "ㄐㄩㄢ"
+	case JUN:
+		return "ㄐㄩㄣ"
+	case QI:
+		return "ㄑㄧ"
+	case QIA:
+		return "ㄑㄧㄚ"
+	case QIAO:
+		return "ㄑㄧㄠ"
+	case QIE:
+		return "ㄑㄧㄝ"
+	case QIU:
+		return "ㄑㄧㄡ"
+	case QIAN:
+		return "ㄑㄧㄢ"
+	case QIN:
+		return "ㄑㄧㄣ"
+	case QIANG:
+		return "ㄑㄧㄤ"
+	case QING:
+		return "ㄑㄧㄥ"
+	case QIONG:
+		return "ㄑㄩㄥ"
+	case QU:
+		return "ㄑㄩ"
+	case QUE:
+		return "ㄑㄩㄝ"
+	case QUAN:
+		return "ㄑㄩㄢ"
+	case QUN:
+		return "ㄑㄩㄣ"
+	case XI:
+		return "ㄒㄧ"
+	case XIA:
+		return "ㄒㄧㄚ"
+	case XIAO:
+		return "ㄒㄧㄠ"
+	case XIE:
+		return "ㄒㄧㄝ"
+	case XIU:
+		return "ㄒㄧㄡ"
+	case XIAN:
+		return "ㄒㄧㄢ"
+	case XIN:
+		return "ㄒㄧㄣ"
+	case XIANG:
+		return "ㄒㄧㄤ"
+	case XING:
+		return "ㄒㄧㄥ"
+	case XIONG:
+		return "ㄒㄩㄥ"
+	case XU:
+		return "ㄒㄩ"
+	case XUE:
+		return "ㄒㄩㄝ"
+	case XUAN:
+		return "ㄒㄩㄢ"
+	case XUN:
+		return "ㄒㄩㄣ"
+	case FIAO:
+		return "ㄈㄧㄠ"
+	case N:
+		return "ㄋ"
+	case NG:
+		return "ㄫ"
+	case M:
+		return "ㄇ"
+	case YO:
+		return "ㄧㄛ"
+	case HM:
+		return "ㄏㄇ"
+	case LO:
+		return "ㄌㄛ"
+	case EI:
+		return "ㄟ"
+	case NUN:
+		return "ㄋㄨㄣ"
+	case CEI:
+		return "ㄘㄟ"
+	case WONG:
+		return "ㄨㄥ"
+	case DIN:
+		return "ㄉㄧㄣ"
+	case BIANG:
+		return "ㄅㄧㄤ"
+	case R:
+		return "ㄦ"
+	default:
+		return "?"
+	}
+}
+
+func zhuyinSound(str string) (bool, Sound) {
+	switch str {
+	case "ㄚ":
+		return true, A
+	case "ㄛ":
+		return true, O
+	case "ㄜ":
+		return true, E
+	case "ㄦ":
+		return true, ER
+	case "ㄞ":
+		return true, AI
+	case "ㄠ":
+		return true, AO
+	case "ㄡ":
+		return true, OU
+	case "ㄢ":
+		return true, AN
+	case "ㄣ":
+		return true, EN
+	case "ㄤ":
+		return true, ANG
+	case "ㄥ":
+		return true, ENG
+	case "ㄧ":
+		return true, YI
+	case "ㄧㄚ":
+		return true, YA
+	case "ㄧㄠ":
+		return true, YAO
+	case "ㄧㄝ":
+		return true, YE
+	case "ㄧㄡ":
+		return true, YOU
+	case "ㄧㄢ":
+		return true, YAN
+	case "ㄧㄣ":
+		return true, YIN
+	case "ㄧㄤ":
+		return true, YANG
+	case "ㄧㄥ":
+		return true, YING
+	case "ㄩㄥ":
+		return true, YONG
+	case "ㄨ":
+		return true, WU
+	case "ㄨㄚ":
+		return true, WA
+	case "ㄨㄛ":
+		return true, WO
+	case "ㄨㄞ":
+		return true, WAI
+	case "ㄨㄟ":
+		return true, WEI
+	case "ㄨㄢ":
+		return true, WAN
+	case "ㄨㄣ":
+		return true, WEN
+	case "ㄨㄤ":
+		return true, WANG
+	case "ㄨㄥ":
+		return true, WENG
+	case "ㄩ":
+		return true, YU
+	case "ㄩㄝ":
+		return true, YUE
+	case "ㄩㄢ":
+		return true, YUAN
+	case "ㄩㄣ":
+		return true, YUN
+	case "ㄅㄚ":
+		return true, BA
+	case "ㄅㄛ":
+		return true, BO
+	case "ㄅㄞ":
+		return true, BAI
+	case "ㄅㄟ":
+		return true, BEI
+	case "ㄅㄠ":
+		return true, BAO
+	case "ㄅㄢ":
+		return true, BAN
+	case "ㄅㄣ":
+		return true, BEN
+	case "ㄅㄤ":
+		return true, BANG
+	case "ㄅㄥ":
+		return true, BENG
+	case "ㄅㄧ":
+		return true, BI
+	case "ㄅㄧㄠ":
+		return true, BIAO
+	case "ㄅㄧㄝ":
+		return true, BIE
+	case "ㄅㄧㄢ":
+		return true, BIAN
+	case "ㄅㄧㄣ":
+		return true, BIN
+	case "ㄅㄧㄥ":
+		return true, BING
+	case "ㄅㄨ":
+		return true, BU
+	case "ㄆㄚ":
+		return true, PA
+	case "ㄆㄛ":
+		return true, PO
+	case "ㄆㄞ":
+		return true, PAI
+	case "ㄆㄟ":
+		return true, PEI
+	case "ㄆㄠ":
+		return true, PAO
+	case "ㄆㄡ":
+		return true, POU
+	case "ㄆㄢ":
+		return true, PAN
+	case "ㄆㄣ":
+		return true, PEN
+	case "ㄆㄤ":
+		return true, PANG
+	case "ㄆㄥ":
+		return true, PENG
+	case "ㄆㄧ":
+		return true, PI
+	case "ㄆㄧㄠ":
+		return true, PIAO
+	case "ㄆㄧㄝ":
+		return true, PIE
+	case "ㄆㄧㄢ":
+		return true, PIAN
+	case "ㄆㄧㄣ":
+		return true, PIN
+	case "ㄆㄧㄥ":
+		return true, PING
+	case "ㄆㄨ":
+		return true, PU
+	case "ㄇㄚ":
+		return true, MA
+	case "ㄇㄛ":
+		return true, MO
+	case "ㄇㄜ":
+		return true, ME
+	case "ㄇㄞ":
+		return true, MAI
+	case "ㄇㄟ":
+		return true, MEI
+	case "ㄇㄠ":
+		return true, MAO
+	case "ㄇㄡ":
+		return true, MOU
+	case "ㄇㄢ":
+		return true, MAN
+	case "ㄇㄣ":
+		return true, MEN
+	case "ㄇㄤ":
+		return true, MANG
+	case "ㄇㄥ":
+		return true, MENG
+	case "ㄇㄧ":
+		return true, MI
+	case "ㄇㄧㄠ":
+		return true, MIAO
+	case "ㄇㄧㄝ":
+		return true, MIE
+	case "ㄇㄧㄡ":
+		return true, MIU
+	case "ㄇㄧㄢ":
+		return true, MIAN
+	case "ㄇㄧㄣ":
+		return true, MIN
+	case "ㄇㄧㄥ":
+		return true, MING
+	case "ㄇㄨ":
+		return true, MU
+	case "ㄈㄚ":
+		return true, FA
+	case "ㄈㄛ":
+		return true, FO
+	case "ㄈㄟ":
+		return true, FEI
+	case "ㄈㄡ":
+		return true, FOU
+	case "ㄈㄢ":
+		return true, FAN
+	case "ㄈㄣ":
+		return true, FEN
+	case "ㄈㄤ":
+		return true, FANG
+	case "ㄈㄥ":
+		return true, FENG
+	case "ㄈㄨ":
+		return true, FU
+	case "ㄉㄚ":
+		return true, DA
+	case "ㄉㄜ":
+		return true, DE
+	case "ㄉㄞ":
+		return true, DAI
+	case "ㄉㄟ":
+		return true, DEI
+	case "ㄉㄠ":
+		return true, DAO
+	case "ㄉㄡ":
+		return true, DOU
+	case "ㄉㄢ":
+		return true, DAN
+	case "ㄉㄣ":
+		return true, DEN
+	case "ㄉㄤ":
+		return true, DANG
+	case "ㄉㄥ":
+		return true, DENG
+	case "ㄉㄨㄥ":
+		return true, DONG
+	case "ㄉㄧ":
+		return true, DI
+	case "ㄉㄧㄠ":
+		return true, DIAO
+	case "ㄉㄧㄝ":
+		return true, DIE
+	case "ㄉㄧㄡ":
+		return true, DIU
+	case "ㄉㄧㄢ":
+		return true, DIAN
+	case "ㄉㄧㄥ":
+		return true, DING
+	case "ㄉㄨ":
+		return true, DU
+	case "ㄉㄨㄛ":
+		return true, DUO
+	case "ㄉㄨㄟ":
+		return true, DUI
+	case "ㄉㄨㄢ":
+		return true, DUAN
+	case "ㄉㄨㄣ":
+		return true, DUN
+	case "ㄊㄚ":
+		return true, TA
+	case "ㄊㄜ":
+		return true, TE
+	case "ㄊㄞ":
+		return true, TAI
+	case "ㄊㄟ":
+		return true, TEI
+	case "ㄊㄠ":
+		return true, TAO
+	case "ㄊㄡ":
+		return true, TOU
+	case "ㄊㄢ":
+		return true, TAN
+	case "ㄊㄤ":
+		return true, TANG
+	case "ㄊㄥ":
+		return true, TENG
+	case "ㄊㄨㄥ":
+		return true, TONG
+	case "ㄊㄧ":
+		return true, TI
+	case "ㄊㄧㄠ":
+		return true, TIAO
+	case "ㄊㄧㄝ":
+		return true, TIE
+	case "ㄊㄧㄢ":
+		return true, TIAN
+	case "ㄊㄧㄥ":
+		return true, TING
+	case "ㄊㄨ":
+		return true, TU
+	case "ㄊㄨㄛ":
+		return true, TUO
+	case "ㄊㄨㄟ":
+		return true, TUI
+	case "ㄊㄨㄢ":
+		return true, TUAN
+	case "ㄊㄨㄣ":
+		return true, TUN
+	case "ㄋㄚ":
+		return true, NA
+	case "ㄋㄜ":
+		return true, NE
+	case "ㄋㄞ":
+		return true, NAI
+	case "ㄋㄟ":
+		return true, NEI
+	case "ㄋㄠ":
+		return true, NAO
+	case "ㄋㄡ":
+		return true, NOU
+	case "ㄋㄢ":
+		return true, NAN
+	case "ㄋㄣ":
+		return true, NEN
+	case "ㄋㄤ":
+		return true, NANG
+	case "ㄋㄥ":
+		return true, NENG
+	case "ㄋㄨㄥ":
+		return true, NONG
+	case "ㄋㄧ":
+		return true, NI
+	case "ㄋㄧㄠ":
+		return true, NIAO
+	case "ㄋㄧㄝ":
+		return true, NIE
+	case "ㄋㄧㄡ":
+		return true, NIU
+	case "ㄋㄧㄢ":
+		return true, NIAN
+	case "ㄋㄧㄣ":
+		return true, NIN
+	case "ㄋㄧㄤ":
+		return true, NIANG
+	case "ㄋㄧㄥ":
+		return true, NING
+	case "ㄋㄨ":
+		return true, NU
+	case "ㄋㄨㄛ":
+		return true, NUO
+	case "ㄋㄨㄢ":
+		return true, NUAN
+	case "ㄋㄩ":
+		return true, NÜ
+	case "ㄋㄩㄝ":
+		return true, NÜE
+	case "ㄌㄚ":
+		return true, LA
+	case "ㄌㄜ":
+		return true, LE
+	case "ㄌㄞ":
+		return true, LAI
+	case "ㄌㄟ":
+		return true, LEI
+	case "ㄌㄠ":
+		return true, LAO
+	case "ㄌㄡ":
+		return true, LOU
+	case "ㄌㄢ":
+		return true, LAN
+	case "ㄌㄤ":
+		return true, LANG
+	case "ㄌㄥ":
+		return true, LENG
+	case "ㄌㄨㄥ":
+		return true, LONG
+	case "ㄌㄧ":
+		return true, LI
+	case "ㄌㄧㄚ":
+		return true, LIA
+	case "ㄌㄧㄠ":
+		return true, LIAO
+	case "ㄌㄧㄝ":
+		return true, LIE
+	case "ㄌㄧㄡ":
+		return true, LIU
+	case "ㄌㄧㄢ":
+		return true, LIAN
+	case "ㄌㄧㄣ":
+		return true, LIN
+	case "ㄌㄧㄤ":
+		return true, LIANG
+	case "ㄌㄧㄥ":
+		return true, LING
+	case "ㄌㄨ":
+		return true, LU
+	case "ㄌㄨㄛ":
+		return true, LUO
+	case "ㄌㄨㄢ":
+		return true, LUAN
+	case "ㄌㄨㄣ":
+		return true, LUN
+	case "ㄌㄩ":
+		return true, LÜ
+	case "ㄌㄩㄝ":
+		return true, LÜE
+	case "ㄍㄚ":
+		return true, GA
+	case "ㄍㄜ":
+		return true, GE
+	case "ㄍㄞ":
+		return true, GAI
+	case "ㄍㄟ":
+		return true, GEI
+	case "ㄍㄠ":
+		return true, GAO
+	case "ㄍㄡ":
+		return true, GOU
+	case "ㄍㄢ":
+		return true, GAN
+	case "ㄍㄣ":
+		return true, GEN
+	case "ㄍㄤ":
+		return true, GANG
+	case "ㄍㄥ":
+		return true, GENG
+	case "ㄍㄨㄥ":
+		return true, GONG
+	case "ㄍㄨ":
+		return true, GU
+	case "ㄍㄨㄚ":
+		return true, GUA
+	case "ㄍㄨㄛ":
+		return true, GUO
+	case "ㄍㄨㄞ":
+		return true, GUAI
+	case "ㄍㄨㄟ":
+		return true, GUI
+	case "ㄍㄨㄢ":
+		return true, GUAN
+	case "ㄍㄨㄣ":
+		return true, GUN
+	case "ㄍㄨㄤ":
+		return true, GUANG
+	case "ㄎㄚ":
+		return true, KA
+	case "ㄎㄜ":
+		return true, KE
+	case "ㄎㄞ":
+		return true, KAI
+	case "ㄎㄟ":
+		return true, KEI
+	case "ㄎㄠ":
+		return true, KAO
+	case "ㄎㄡ":
+		return true, KOU
+	case "ㄎㄢ":
+		return true, KAN
+	case "ㄎㄣ":
+		return true, KEN
+	case "ㄎㄤ":
+		return true, KANG
+	case "ㄎㄥ":
+		return true, KENG
+	case "ㄎㄨㄥ":
+		return true, KONG
+	case "ㄎㄨ":
+		return true, KU
+	case "ㄎㄨㄚ":
+		return true, KUA
+	case "ㄎㄨㄛ":
+		return true, KUO
+	case "ㄎㄨㄞ":
+		return true, KUAI
+	case "ㄎㄨㄟ":
+		return true, KUI
+	case "ㄎㄨㄢ":
+		return true, KUAN
+	case "ㄎㄨㄣ":
+		return true, KUN
+	case "ㄎㄨㄤ":
+		return true, KUANG
+	case "ㄏㄚ":
+		return true, HA
+	case "ㄏㄜ":
+		return true, HE
+	case "ㄏㄞ":
+		return true, HAI
+	case "ㄏㄟ":
+		return true, HEI
+	case "ㄏㄠ":
+		return true, HAO
+	case "ㄏㄡ":
+		return true, HOU
+	case "ㄏㄢ":
+		return true, HAN
+	case "ㄏㄣ":
+		return true, HEN
+	case "ㄏㄤ":
+		return true, HANG
+	case "ㄏㄥ":
+		return true, HENG
+	case "ㄏㄨㄥ":
+		return true, HONG
+	case "ㄏㄨ":
+		return true, HU
+	case "ㄏㄨㄚ":
+		return true, HUA
+	case "ㄏㄨㄛ":
+		return true, HUO
+	case "ㄏㄨㄞ":
+		return true, HUAI
+	case "ㄏㄨㄟ":
+		return true, HUI
+	case "ㄏㄨㄢ":
+		return true, HUAN
+	case "ㄏㄨㄣ":
+		return true, HUN
+	case "ㄏㄨㄤ":
+		return true, HUANG
+	case "ㄗㄚ":
+		return true, ZA
+	case "ㄗㄜ":
+		return true, ZE
+	case "ㄗ":
+		return true, ZI
+	case "ㄗㄞ":
+		return true, ZAI
+	case "ㄗㄟ":
+		return true, ZEI
+	case "ㄗㄠ":
+		return true, ZAO
+	case "ㄗㄡ":
+		return true, ZOU
+	case "ㄗㄢ":
+		return true, ZAN
+	case "ㄗㄣ":
+		return true, ZEN
+	case "ㄗㄤ":
+		return true, ZANG
+	case "ㄗㄥ":
+		return true, ZENG
+	case "ㄗㄨㄥ":
+		return true, ZONG
+	case "ㄗㄨ":
+		return true, ZU
+	case "ㄗㄨㄛ":
+		return true, ZUO
+	case "ㄗㄨㄟ":
+		return true, ZUI
+	case "ㄗㄨㄢ":
+		return true, ZUAN
+	case "ㄗㄨㄣ":
+		return true, ZUN
+	case "ㄘㄚ":
+		return true, CA
+	case "ㄘㄜ":
+		return true, CE
+	case "ㄘ":
+		return true, CI
+	case "ㄘㄞ":
+		return true, CAI
+	case "ㄘㄠ":
+		return true, CAO
+	case "ㄘㄡ":
+		return true, COU
+	case "ㄘㄢ":
+		return true, CAN
+	case "ㄘㄣ":
+		return true, CEN
+	case "ㄘㄤ":
+		return true, CANG
+	case "ㄘㄥ":
+		return true, CENG
+	case "ㄘㄨㄥ":
+		return true, CONG
+	case "ㄘㄨ":
+		return true, CU
+	case "ㄘㄨㄛ":
+		return true, CUO
+	case "ㄘㄨㄟ":
+		return true, CUI
+	case "ㄘㄨㄢ":
+		return true, CUAN
+	case "ㄘㄨㄣ":
+		return true, CUN
+	case "ㄙㄚ":
+		return true, SA
+	case "ㄙㄜ":
+		return true, SE
+	case "ㄙ":
+		return true, SI
+	case "ㄙㄞ":
+		return true, SAI
+	case "ㄙㄠ":
+		return true, SAO
+	case "ㄙㄡ":
+		return true, SOU
+	case "ㄙㄢ":
+		return true, SAN
+	case "ㄙㄣ":
+		return true, SEN
+	case "ㄙㄤ":
+		return true, SANG
+	case "ㄙㄥ":
+		return true, SENG
+	case "ㄙㄨㄥ":
+		return true, SONG
+	case "ㄙㄨ":
+		return true, SU
+	case "ㄙㄨㄛ":
+		return true, SUO
+	case "ㄙㄨㄟ":
+		return true, SUI
+	case "ㄙㄨㄢ":
+		return true, SUAN
+	case "ㄙㄨㄣ":
+		return true, SUN
+	case "ㄓㄚ":
+		return true, ZHA
+	case "ㄓㄜ":
+		return true, ZHE
+	case "ㄓ":
+		return true, ZHI
+	case "ㄓㄞ":
+		return true, ZHAI
+	case "ㄓㄟ":
+		return true, ZHEI
+	case "ㄓㄠ":
+		return true, ZHAO
+	case "ㄓㄡ":
+		return true, ZHOU
+	case "ㄓㄢ":
+		return true, ZHAN
+	case "ㄓㄣ":
+		return true, ZHEN
+	case "ㄓㄤ":
+		return true, ZHANG
+	case "ㄓㄥ":
+		return true, ZHENG
+	case "ㄓㄨㄥ":
+		return true, ZHONG
+	case "ㄓㄨ":
+		return true, ZHU
+	case "ㄓㄨㄚ":
+		return true, ZHUA
+	case "ㄓㄨㄛ":
+		return true, ZHUO
+	case "ㄓㄨㄞ":
+		return true, ZHUAI
+	case "ㄓㄨㄟ":
+		return true, ZHUI
+	case "ㄓㄨㄢ":
+		return true, ZHUAN
+	case "ㄓㄨㄣ":
+		return true, ZHUN
+	case "ㄓㄨㄤ":
+		return true, ZHUANG
+	case "ㄔㄚ":
+		return true, CHA
+	case "ㄔㄜ":
+		return true, CHE
+	case "ㄔ":
+		return true, CHI
+	case "ㄔㄞ":
+		return true, CHAI
+	case "ㄔㄠ":
+		return true, CHAO
+	case "ㄔㄡ":
+		return true, CHOU
+	case "ㄔㄢ":
+		return true, CHAN
+	case "ㄔㄣ":
+		return true, CHEN
+	case "ㄔㄤ":
+		return true, CHANG
+	case "ㄔㄥ":
+		return true, CHENG
+	case "ㄔㄨㄥ":
+		return true, CHONG
+	case "ㄔㄨ":
+		return true, CHU
+	case "ㄔㄨㄚ":
+		return true, CHUA
+	case "ㄔㄨㄛ":
+		return true, CHUO
+	case "ㄔㄨㄞ":
+		return true, CHUAI
+	case "ㄔㄨㄟ":
+		return true, CHUI
+	case "ㄔㄨㄢ":
+		return true, CHUAN
+	case "ㄔㄨㄣ":
+		return true, CHUN
+	case "ㄔㄨㄤ":
+		return true, CHUANG
+	case "ㄕㄚ":
+		return true, SHA
+	case "ㄕㄜ":
+		return true, SHE
+	case "ㄕ":
+		return true, SHI
+	case "ㄕㄞ":
+		return true, SHAI
+	case "ㄕㄟ":
+		return true, SHEI
+	case "ㄕㄠ":
+		return true, SHAO
+	case "ㄕㄡ":
+		return true, SHOU
+	case "ㄕㄢ":
+		return true, SHAN
+	case "ㄕㄣ":
+		return true, SHEN
+	case "ㄕㄤ":
+		return true, SHANG
+	case "ㄕㄥ":
+		return true, SHENG
+	case "ㄕㄨ":
+		return true, SHU
+	case "ㄕㄨㄚ":
+		return true, SHUA
+	case "ㄕㄨㄛ":
+		return true, SHUO
+	case "ㄕㄨㄞ":
+		return true, SHUAI
+	case "ㄕㄨㄟ":
+		return true, SHUI
+	case "ㄕㄨㄢ":
+		return true, SHUAN
+	case "ㄕㄨㄣ":
+		return true, SHUN
+	case "ㄕㄨㄤ":
+		return true, SHUANG
+	case "ㄖㄜ":
+		return true, RE
+	case "ㄖ":
+		return true, RI
+	case "ㄖㄠ":
+		return true, RAO
+	case "ㄖㄡ":
+		return true, ROU
+	case "ㄖㄢ":
+		return true, RAN
+	case "ㄖㄣ":
+		return true, REN
+	case "ㄖㄤ":
+		return true, RANG
+	case "ㄖㄥ":
+		return true, RENG
+	case "ㄖㄨㄥ":
+		return true, RONG
+	case "ㄖㄨ":
+		return true, RU
+	case "ㄖㄨㄚ":
+		return true, RUA
+	case "ㄖㄨㄛ":
+		return true, RUO
+	case "ㄖㄨㄟ":
+		return true, RUI
+	case "ㄖㄨㄢ":
+		return true, RUAN
+	case "ㄖㄨㄣ":
+		return true, RUN
+	case "ㄐㄧ":
+		return true, JI
+	case "ㄐㄧㄚ":
+		return true, JIA
+	case "ㄐㄧㄠ":
+		return true, JIAO
+	case "ㄐㄧㄝ":
+		return true, JIE
+	case "ㄐㄧㄡ":
+		return true, JIU
+	case "ㄐㄧㄢ":
+		return true, JIAN
+	case "ㄐㄧㄣ":
+		return true, JIN
+	case "ㄐㄧㄤ":
+		return true, JIANG
+	case "ㄐㄧㄥ":
+		return true, JING
+	case "ㄐㄩㄥ":
+		return true, JIONG
+	case "ㄐㄩ":
+		return true, JU
+	case "ㄐㄩㄝ":
+		return true, JUE
+	case "ㄐㄩㄢ":
+		return true, JUAN
+	case "ㄐㄩㄣ":
+		return true, JUN
+	case "ㄑㄧ":
+		return true, QI
+	case "ㄑㄧㄚ":
+		return true, QIA
+	case "ㄑㄧㄠ":
+		return true, QIAO
+	case "ㄑㄧㄝ":
+		return true, QIE
+	case "ㄑㄧㄡ":
+		return true, QIU
+	case "ㄑㄧㄢ":
+		return true, QIAN
+	case "ㄑㄧㄣ":
+		return true, QIN
+	case "ㄑㄧㄤ":
+		return true, QIANG
+	case "ㄑㄧㄥ":
+		return true, QING
+	case "ㄑㄩㄥ":
+		return true, QIONG
+	case "ㄑㄩ":
+		return true, QU
+	case "ㄑㄩㄝ":
+		return true, QUE
+	case "ㄑㄩㄢ":
+		return true, QUAN
+	case "ㄑㄩㄣ":
+		return true, QUN
+	case "ㄒㄧ":
+		return true, XI
+	case "ㄒㄧㄚ":
+		return true, XIA
+	case "ㄒㄧㄠ":
+		return true, XIAO
+	case "ㄒㄧㄝ":
+		return true, XIE
+	case "ㄒㄧㄡ":
+		return true, XIU
+	case "ㄒㄧㄢ":
+		return true, XIAN
+	case "ㄒㄧㄣ":
+		return true, XIN
+	case "ㄒㄧㄤ":
+		return true, XIANG
+	case "ㄒㄧㄥ":
+		return true, XING
+	case "ㄒㄩㄥ":
+		return true, XIONG
+	case "ㄒㄩ":
+		return true, XU
+	case "ㄒㄩㄝ":
+		return true, XUE
+	case "ㄒㄩㄢ":
+		return true, XUAN
+	case "ㄒㄩㄣ":
+		return true, XUN
+	case "ㄈㄧㄠ":
+		return true, FIAO
+	case "ㄋ":
+		return true, N
+	case "ㄫ":
+		return true, NG
+	case "ㄇ":
+		return true, M
+	case "ㄧㄛ":
+		return true, YO
+	case "ㄏㄇ":
+		return true, HM
+	case "ㄌㄛ":
+		return true, LO
+	case "ㄟ":
+		return true, EI
+	case "ㄋㄨㄣ":
+		return true, NUN
+	case "ㄘㄟ":
+		return true, CEI
+	case "ㄉㄧㄣ":
+		return true, DIN
+	case "ㄅㄧㄤ":
+		return true, BIANG
+	default:
+		return false, 0
+	}
+}
+
+const maxZhuyinLength = 3
+
+func (p Sound) wadeGiles() string {
+	switch p {
+	case A:
+		return "a"
+	case O:
+		return "o"
+	case E:
+		return "ê"
+	case ER:
+		return "êrh"
+	case AI:
+		return "ai"
+	case AO:
+		return "ao"
+	case OU:
+		return "ou"
+	case AN:
+		return "an"
+	case EN:
+		return "ên"
+	case ANG:
+		return "ang"
+	case ENG:
+		return "êng"
+	case YI:
+		return "i"
+	case YA:
+		return "ya"
+	case YAO:
+		return "yao"
+	case YE:
+		return "yeh"
+	case YOU:
+		return "yu"
+	case YAN:
+		return "yen"
+	case YIN:
+		return "yin"
+	case YANG:
+		return "yang"
+	case YING:
+		return "ying"
+	case YONG:
+		return "yung"
+	case WU:
+		return "wu"
+	case WA:
+		return "wa"
+	case WO:
+		return "wo"
+	case WAI:
+		return "wai"
+	case WEI:
+		return "wei"
+	case WAN:
+		return "wan"
+	case WEN:
+		return "wên"
+	case WANG:
+		return "wang"
+	case WENG:
+		return "wêng"
+	case YU:
+		return "yü"
+	case YUE:
+		return "yüeh"
+	case YUAN:
+		return "yüan"
+	case YUN:
+		return "yün"
+	case BA:
+		return "pa"
+	case BO:
+		return "po"
+	case BAI:
+		return "pai"
+	case BEI:
+		return "pei"
+	case BAO:
+		return "pao"
+	case BAN:
+		return "pan"
+	case BEN:
+		return "pên"
+	case BANG:
+		return "pang"
+	case BENG:
+		return "pêng"
+	case BI:
+		return "pi"
+	case BIAO:
+		return "piao"
+	case BIE:
+		return "pieh"
+	case BIAN:
+		return "pien"
+	case BIN:
+		return "pin"
+	case BING:
+		return "ping"
+	case BU:
+		return "pu"
+	case PA:
+		return "p'a"
+	case PO:
+		return "p'o"
+	case PAI:
+		return "p'ai"
+	case PEI:
+		return "p'ei"
+	case PAO:
+		return "p'ao"
+	case POU:
+		return "p'ou"
+	case PAN:
+		return "p'an"
+	case PEN:
+		return "p'ên"
+	case PANG:
+		return "p'ang"
+	case PENG:
+		return "p'êng"
+	case PI:
+		return "p'i"
+	case PIAO:
+		return "p'iao"
+	case PIE:
+		return "p'ieh"
+	case PIAN:
+		return "p'ien"
+	case PIN:
+		return "p'in"
+	case PING:
+		return "p'ing"
+	case PU:
+		return "p'u"
+	case MA:
+		return "ma"
+	case MO:
+		return "mo"
+	case ME:
+		return "mê"
+	case MAI:
+		return "mai"
+	case MEI:
+		return "mei"
+	case MAO:
+		return "mao"
+	case MOU:
+		return "mou"
+	case MAN:
+		return "man"
+	case MEN:
+		return "mên"
+	case MANG:
+		return "mang"
+	case MENG:
+		return "mêng"
+	case MI:
+		return "mi"
+	case MIAO:
+		return "miao"
+	case MIE:
+		return "mieh"
+	case MIU:
+		return "miu"
+	case MIAN:
+		return "mien"
+	case MIN:
+		return "min"
+	case MING:
+		return "ming"
+	case MU:
+		return "mu"
+	case FA:
+		return "fa"
+	case FO:
+		return "fo"
+	case FEI:
+		return "fei"
+	case FOU:
+		return "fou"
+	case FAN:
+		return "fan"
+	case FEN:
+		return "fên"
+	case FANG:
+		return "fang"
+	case FENG:
+		return "fêng"
+	case FU:
+		return "fu"
+	case DA:
+		return "ta"
+	case DE:
+		return "tê"
+	case DAI:
+		return "tai"
+	case DEI:
+		return "tei"
+	case DAO:
+		return "tao"
+	case DOU:
+		return "tou"
+	case DAN:
+		return "tan"
+	case DEN:
+		return "tên"
+	case DANG:
+		return "tang"
+	case DENG:
+		return "têng"
+	case DONG:
+		return "tung"
+	case DI:
+		return "ti"
+	case DIAO:
+		return "tiao"
+	case DIE:
+		return "tieh"
+	case DIU:
+		return "tiu"
+	case DIAN:
+		return "tien"
+	case DING:
+		return "ting"
+	case DU:
+		return "tu"
+	case DUO:
+		return "to"
+	case DUI:
+		return "tui"
+	case DUAN:
+		return "tuan"
+	case DUN:
+		return "tun"
+	case TA:
+		return "t'a"
+	case TE:
+		return "t'ê"
+	case TAI:
+		return "t'ai"
+	case TEI:
+		return "t'ei"
+	case TAO:
+		return "t'ao"
+	case TOU:
+		return "t'ou"
+	case TAN:
+		return "t'an"
+	case TANG:
+		return "t'ang"
+	case TENG:
+		return "t'êng"
+	case TONG:
+		return "t'ung"
+	case TI:
+		return "t'i"
+	case TIAO:
+		return "t'iao"
+	case TIE:
+		return "t'ieh"
+	case TIAN:
+		return "t'ien"
+	case TING:
+		return "t'ing"
+	case TU:
+		return "t'u"
+	case TUO:
+		return "t'o"
+	case TUI:
+		return "t'ui"
+	case TUAN:
+		return "t'uan"
+	case TUN:
+		return "t'un"
+	case NA:
+		return "na"
+	case NE:
+		return "nê"
+	case NAI:
+		return "nai"
+	case NEI:
+		return "nei"
+	case NAO:
+		return "nao"
+	case NOU:
+		return "nou"
+	case NAN:
+		return "nan"
+	case NEN:
+		return "nên"
+	case NANG:
+		return "nang"
+	case NENG:
+		return "nêng"
+	case NONG:
+		return "nung"
+	case NI:
+		return "ni"
+	case NIAO:
+		return "niao"
+	case NIE:
+		return "nieh"
+	case NIU:
+		return "niu"
+	case NIAN:
+		return "nien"
+	case NIN:
+		return "nin"
+	case NIANG:
+		return "niang"
+	case NING:
+		return "ning"
+	case NU:
+		return "nu"
+	case NUO:
+		return "no"
+	case NUAN:
+		return "nuan"
+	case NÜ:
+		return "nü"
+	case NÜE:
+		return "nüeh"
+	case LA:
+		return "la"
+	case LE:
+		return "lê"
+	case LAI:
+		return "lai"
+	case LEI:
+		return "lei"
+	case LAO:
+		return "lao"
+	case LOU:
+		return "lou"
+	case LAN:
+		return "lan"
+	case LANG:
+		return "lang"
+	case LENG:
+		return "lêng"
+	case LONG:
+		return "lung"
+	case LI:
+		return "li"
+	case LIA:
+		return "lia"
+	case LIAO:
+		return "liao"
+	case LIE:
+		return "lieh"
+	case LIU:
+		return "liu"
+	case LIAN:
+		return "lien"
+	case LIN:
+		return "lin"
+	case LIANG:
+		return "liang"
+	case LING:
+		return "ling"
+	case LU:
+		return "lu"
+	case LUO:
+		return "lo"
+	case LUAN:
+		return "luan"
+	case LUN:
+		return "lun"
+	case LÜ:
+		return "lü"
+	case LÜE:
+		return "lüeh"
+	case GA:
+		return "ka"
+	case GE:
+		return "ko"
+	case GAI:
+		return "kai"
+	case GEI:
+		return "kei"
+	case GAO:
+		return "kao"
+	case GOU:
+		return "kou"
+	case GAN:
+		return "kan"
+	case GEN:
+		return "kên"
+	case GANG:
+		return "kang"
+	case GENG:
+		return "kêng"
+	case GONG:
+		return "kung"
+	case GU:
+		return "ku"
+	case GUA:
+		return "kua"
+	case GUO:
+		return "kuo"
+	case GUAI:
+		return "kuai"
+	case GUI:
+		return "kuei"
+	case GUAN:
+		return "kuan"
+	case GUN:
+		return "kun"
+	case GUANG:
+		return "kuang"
+	case KA:
+		return "k'a"
+	case KE:
+		return "k'o"
+	case KAI:
+		return "k'ai"
+	case KEI:
+		return "k'ei"
+	case KAO:
+		return "k'ao"
+	case KOU:
+		return "k'ou"
+	case KAN:
+		return "k'an"
+	case KEN:
+		return "k'ên"
+	case KANG:
+		return "k'ang"
+	case KENG:
+		return "k'êng"
+	case KONG:
+		return "k'ung"
+	case KU:
+		return "k'u"
+	case KUA:
+		return "k'ua"
+	case KUO:
+		return "k'uo"
+	case KUAI:
+		return "k'uai"
+	case KUI:
+		return "k'uei"
+	case KUAN:
+		return "k'uan"
+	case KUN:
+		return "k'un"
+	case KUANG:
+		return "k'uang"
+	case HA:
+		return "ha"
+	case HE:
+		return "ho"
+	case HAI:
+		return "hai"
+	case HEI:
+		return "hei"
+	case HAO:
+		return "hao"
+	case HOU:
+		return "hou"
+	case HAN:
+		return "han"
+	case HEN:
+		return "hên"
+	case HANG:
+		return "hang"
+	case HENG:
+		return "hêng"
+	case HONG:
+		return "hung"
+	case HU:
+		return "hu"
+	case HUA:
+		return "hua"
+	case HUO:
+		return "huo"
+	case HUAI:
+		return "huai"
+	case HUI:
+		return "hui"
+	case HUAN:
+		return "huan"
+	case HUN:
+		return "hun"
+	case HUANG:
+		return "huang"
+	case ZA:
+		return "tsa"
+	case ZE:
+		return "tsê"
+	case ZI:
+		return "tzŭ"
+	case ZAI:
+		return "tsai"
+	case ZEI:
+		return "tsei"
+	case ZAO:
+		return "tsao"
+	case ZOU:
+		return "tsou"
+	case ZAN:
+		return "tsan"
+	case ZEN:
+		return "tsên"
+	case ZANG:
+		return "tsang"
+	case ZENG:
+		return "tsêng"
+	case ZONG:
+		return "tsung"
+	case ZU:
+		return "tsu"
+	case ZUO:
+		return "tso"
+	case ZUI:
+		return "tsui"
+	case ZUAN:
+		return "tsuan"
+	case ZUN:
+		return "tsun"
+	case CA:
+		return "ts'a"
+	case CE:
+		return "ts'ê"
+	case CI:
+		return "tz'ŭ"
+	case CAI:
+		return "ts'ai"
+	case CAO:
+		return "ts'ao"
+	case COU:
+		return "ts'ou"
+	case CAN:
+		return "ts'an"
+	case CEN:
+		return "ts'ên"
+	case CANG:
+		return "ts'ang"
+	case CENG:
+		return "ts'êng"
+	case CONG:
+		return "ts'ung"
+	case CU:
+		return "ts'u"
+	case CUO:
+		return "ts'o"
+	case CUI:
+		return "ts'ui"
+	case CUAN:
+		return "ts'uan"
+	case CUN:
+		return "ts'un"
+	case SA:
+		return "sa"
+	case SE:
+		return "sê"
+	case SI:
+		return "ssŭ"
+	case SAI:
+		return "sai"
+	case SAO:
+		return "sao"
+	case SOU:
+		return "sou"
+	case SAN:
+		return "san"
+	case SEN:
+		return "sên"
+	case SANG:
+		return "sang"
+	case SENG:
+		return "sêng"
+	case SONG:
+		return "sung"
+	case SU:
+		return "su"
+	case SUO:
+		return "so"
+	case SUI:
+		return "sui"
+	case SUAN:
+		return "suan"
+	case SUN:
+		return "sun"
+	case ZHA:
+		return "cha"
+	case ZHE:
+		return "chê"
+	case ZHI:
+		return "chih"
+	case ZHAI:
+		return "chai"
+	case ZHEI:
+		return "chei"
+	case ZHAO:
+		return "chao"
+	case ZHOU:
+		return "chou"
+	case ZHAN:
+		return "chan"
+	case ZHEN:
+		return "chên"
+	case ZHANG:
+		return "chang"
+	case ZHENG:
+		return "chêng"
+	case ZHONG:
+		return "chung"
+	case ZHU:
+		return "chu"
+	case ZHUA:
+		return "chua"
+	case ZHUO:
+		return "cho"
+	case ZHUAI:
+		return "chuai"
+	case ZHUI:
+		return "chui"
+	case ZHUAN:
+		return "chuan"
+	case ZHUN:
+		return "chun"
+	case ZHUANG:
+		return "chuang"
+	case CHA:
+		return "ch'a"
+	case CHE:
+		return "ch'ê"
+	case CHI:
+		return "ch'ih"
+	case CHAI:
+		return "ch'ai"
+	case CHAO:
+		return "ch'ao"
+	case CHOU:
+		return "ch'ou"
+	case CHAN:
+		return "ch'an"
+	case CHEN:
+		return "ch'ên"
+	case CHANG:
+		return "ch'ang"
+	case CHENG:
+		return "ch'êng"
+	case CHONG:
+		return "ch'ung"
+	case CHU:
+		return "ch'u"
+	case CHUA:
+		return "ch'ua"
+	case CHUO:
+		return "ch'o"
+	case CHUAI:
+		return "ch'uai"
+	case CHUI:
+		return "ch'ui"
+	case CHUAN:
+		return "ch'uan"
+	case CHUN:
+		return "ch'un"
+	case CHUANG:
+		return "ch'uang"
+	case SHA:
+		return "sha"
+	case SHE:
+		return "shê"
+	case SHI:
+		return "shih"
+	case SHAI:
+		return "shai"
+	case SHEI:
+		return "shei"
+	case SHAO:
+		return "shao"
+	case SHOU:
+		return "shou"
+	case SHAN:
+		return "shan"
+	case SHEN:
+		return "shên"
+	case SHANG:
+		return "shang"
+	case SHENG:
+		return "shêng"
+	case SHU:
+		return "shu"
+	case SHUA:
+		return "shua"
+	case SHUO:
+		return "shuo"
+	case SHUAI:
+		return "shuai"
+	case SHUI:
+		return "shui"
+	case SHUAN:
+		return "shuan"
+	case SHUN:
+		return "shun"
+	case SHUANG:
+		return "shuang"
+	case RE:
+		return "jê"
+	case RI:
+		return "jih"
+	case RAO:
+		return "jao"
+	case ROU:
+		return "jou"
+	case RAN:
+		return "jan"
+	case REN:
+		return "jên"
+	case RANG:
+		return "jang"
+	case RENG:
+		return "jêng"
+	case RONG:
+		return "jung"
+	case RU:
+		return "ju"
+	case RUA:
+		return "jua"
+	case RUO:
+		return "jo"
+	case RUI:
+		return "jui"
+	case RUAN:
+		return "juan"
+	case RUN:
+		return "jun"
+	case JI:
+		return "chi"
+	case JIA:
+		return "chia"
+	case JIAO:
+		return "chiao"
+	case JIE:
+		return "chieh"
+	case JIU:
+		return "chiu"
+	case JIAN:
+		return "chien"
+	case JIN:
+		return "chin"
+	case JIANG:
+		return "chiang"
+	case JING:
+		return "ching"
+	case JIONG:
+		return "chiung"
+	case JU:
+		return "chü"
+	case JUE:
+		return "chüeh"
+	case JUAN:
+		return "chüan"
+	case JUN:
+		return "chün"
+	case QI:
+		return "ch'i"
+	case QIA:
+		return "ch'ia"
+	case QIAO:
+		return "ch'iao"
+	case QIE:
+		return "ch'ieh"
+	case QIU:
+		return "ch'iu"
+	case QIAN:
+		return "ch'ien"
+	case QIN:
+		return "ch'in"
+	case QIANG:
+		return "ch'iang"
+	case QING:
+		return "ch'ing"
+	case QIONG:
+		return "ch'iung"
+	case QU:
+		return "ch'ü"
+	case QUE:
+		return "ch'üeh"
+	case QUAN:
+		return "ch'üan"
+	case QUN:
+		return "ch'ün"
+	case XI:
+		return "hsi"
+	case XIA:
+		return "hsia"
+	case XIAO:
+		return "hsiao"
+	case XIE:
+		return "hsieh"
+	case XIU:
+		return "hsiu"
+	case XIAN:
+		return "hsien"
+	case XIN:
+		return "hsin"
+	case XIANG:
+		return "hsiang"
+	case XING:
+		return "hsing"
+	case XIONG:
+		return "hsiung"
+	case XU:
+		return "hsü"
+	case XUE:
+		return "hsüeh"
+	case XUAN:
+		return "hsüan"
+	case XUN:
+		return "hsün"
+	case FIAO:
+		return "fiao"
+	case N:
+		return "n"
+	case NG:
+		return "ng"
+	case M:
+		return "m"
+	case YO:
+		return "yo"
+	case HM:
+		return "hm"
+	case LO:
+		return "lo"
+	case EI:
+		return "ei"
+	case NUN:
+		return "nun"
+	case CEI:
+		return "ts'ei"
+	case WONG:
+		return "wêng"
+	case DIN:
+		return "tin"
+	case BIANG:
+		return "piang"
+	case R:
+		return "êrh"
+	default:
+		return "?"
+	}
+}
+
+func wadeGilesSound(str string) (bool, Sound) {
+	switch str {
+	case "a":
+		return true, A
+	case "o":
+		return true, O
+	case "ê":
+		return true, E
+	case "êrh":
+		return true, ER
+	case "ai":
+		return true, AI
+	case "ao":
+		return true, AO
+	case "ou":
+		return true, OU
+	case "an":
+		return true, AN
+	case "ên":
+		return true, EN
+	case "ang":
+		return true, ANG
+	case "êng":
+		return true, ENG
+	case "i":
+		return true, YI
+	case "ya":
+		return true, YA
+	case "yao":
+		return true, YAO
+	case "yeh":
+		return true, YE
+	case "yu":
+		return true, YOU
+	case "yen":
+		return true, YAN
+	case "yin":
+		return true, YIN
+	case "yang":
+		return true, YANG
+	case "ying":
+		return true, YING
+	case "yung":
+		return true, YONG
+	case "wu":
+		return true, WU
+	case "wa":
+		return true, WA
+	case "wo":
+		return true, WO
+	case "wai":
+		return true, WAI
+	case "wei":
+		return true, WEI
+	case "wan":
+		return true, WAN
+	case "wên":
+		return true, WEN
+	case "wang":
+		return true, WANG
+	case "wêng":
+		return true, WENG
+	case "yü":
+		return true, YU
+	case "yüeh":
+		return true, YUE
+	case "yüan":
+		return true, YUAN
+	case "yün":
+		return true, YUN
+	case "pa":
+		return true, BA
+	case "po":
+		return true, BO
+	case "pai":
+		return true, BAI
+	case "pei":
+		return true, BEI
+	case "pao":
+		return true, BAO
+	case "pan":
+		return true, BAN
+	case "pên":
+		return true, BEN
+	case "pang":
+		return true, BANG
+	case "pêng":
+		return true, BENG
+	case "pi":
+		return true, BI
+	case "piao":
+		return true, BIAO
+	case "pieh":
+		return true, BIE
+	case "pien":
+		return true, BIAN
+	case "pin":
+		return true, BIN
+	case "ping":
+		return true, BING
+	case "pu":
+		return true, BU
+	case "p'a":
+		return true, PA
+	case "p'o":
+		return true, PO
+	case "p'ai":
+		return true, PAI
+	case "p'ei":
+		return true, PEI
+	case "p'ao":
+		return true, PAO
+	case "p'ou":
+		return true, POU
+	case "p'an":
+		return true, PAN
+	case "p'ên":
+		return true, PEN
+	case "p'ang":
+		return true, PANG
+	case "p'êng":
+		return true, PENG
+	case "p'i":
+		return true, PI
+	case "p'iao":
+		return true, PIAO
+	case "p'ieh":
+		return true, PIE
+	case "p'ien":
+		return true, PIAN
+	case "p'in":
+		return true, PIN
+	case "p'ing":
+		return true, PING
+	case "p'u":
+		return true, PU
+	case "ma":
+		return true, MA
+	case "mo":
+		return true, MO
+	case "mê":
+		return true, ME
+	case "mai":
+		return true, MAI
+	case "mei":
+		return true, MEI
+	case "mao":
+		return true, MAO
+	case "mou":
+		return true, MOU
+	case "man":
+		return true, MAN
+	case "mên":
+		return true, MEN
+	case "mang":
+		return true, MANG
+	case "mêng":
+		return true, MENG
+	case "mi":
+		return true, MI
+	case "miao":
+		return true, MIAO
+	case "mieh":
+		return true, MIE
+	case "miu":
+		return true, MIU
+	case "mien":
+		return true, MIAN
+	case "min":
+		return true, MIN
+	case "ming":
+		return true, MING
+	case "mu":
+		return true, MU
+	case "fa":
+		return true, FA
+	case "fo":
+		return true, FO
+	case "fei":
+		return true, FEI
+	case "fou":
+		return true, FOU
+	case "fan":
+		return true, FAN
+	case "fên":
+		return true, FEN
+	case "fang":
+		return true, FANG
+	case "fêng":
+		return true, FENG
+	case "fu":
+		return true, FU
+	case "ta":
+		return true, DA
+	case "tê":
+		return true, DE
+	case "tai":
+		return true, DAI
+	case "tei":
+		return true, DEI
+	case "tao":
+		return true, DAO
+	case "tou":
+		return true, DOU
+	case "tan":
+		return true, DAN
+	case "tên":
+		return true, DEN
+	case "tang":
+		return true, DANG
+	case "têng":
+		return true, DENG
+	case "tung":
+		return true, DONG
+	case "ti":
+		return true, DI
+	case "tiao":
+		return true, DIAO
+	case "tieh":
+		return true, DIE
+	case "tiu":
+		return true, DIU
+	case "tien":
+		return true, DIAN
+	case "ting":
+		return true, DING
+	case "tu":
+		return true, DU
+	case "to":
+		return true, DUO
+	case "tui":
+		return true, DUI
+	case "tuan":
+		return true, DUAN
+	case "tun":
+		return true, DUN
+	case "t'a":
+		return true, TA
+	case "t'ê":
+		return true, TE
+	case "t'ai":
+		return true, TAI
+	case "t'ei":
+		return true, TEI
+	case "t'ao":
+		return true, TAO
+	case "t'ou":
+		return true, TOU
+	case "t'an":
+		return true, TAN
+	case "t'ang":
+		return true, TANG
+	case "t'êng":
+		return true, TENG
+	case "t'ung":
+		return true, TONG
+	case "t'i":
+		return true, TI
+	case "t'iao":
+		return true, TIAO
+	case "t'ieh":
+		return true, TIE
+	case "t'ien":
+		return true, TIAN
+	case "t'ing":
+		return true, TING
+	case "t'u":
+		return true, TU
+	case "t'o":
+		return true, TUO
+	case "t'ui":
+		return true, TUI
+	case "t'uan":
+		return true, TUAN
+	case "t'un":
+		return true, TUN
+	case "na":
+		return true, NA
+	case "nê":
+		return true, NE
+	case "nai":
+		return true, NAI
+	case "nei":
+		return true, NEI
+	case "nao":
+		return true, NAO
+	case "nou":
+		return true, NOU
+	case "nan":
+		return true, NAN
+	case "nên":
+		return true, NEN
+	case "nang":
+		return true, NANG
+	case "nêng":
+		return true, NENG
+	case "nung":
+		return true, NONG
+	case "ni":
+		return true, NI
+	case "niao":
+		return true, NIAO
+	case "nieh":
+		return true, NIE
+	case "niu":
+		return true, NIU
+	case "nien":
+		return true, NIAN
+	case "nin":
+		return true, NIN
+	case "niang":
+		return true, NIANG
+	case "ning":
+		return true, NING
+	case "nu":
+		return true, NU
+	case "no":
+		return true, NUO
+	case "nuan":
+		return true, NUAN
+	case "nü":
+		return true, NÜ
+	case "nüeh":
+		return true, NÜE
+	case "la":
+		return true, LA
+	case "lê":
+		return true, LE
+	case "lai":
+		return true, LAI
+	case "lei":
+		return true, LEI
+	case "lao":
+		return true, LAO
+	case "lou":
+		return true, LOU
+	case "lan":
+		return true, LAN
+	case "lang":
+		return true, LANG
+	case "lêng":
+		return true, LENG
+	case "lung":
+		return true, LONG
+	case "li":
+		return true, LI
+	case "lia":
+		return true, LIA
+	case "liao":
+		return true, LIAO
+	case "lieh":
+		return true, LIE
+	case "liu":
+		return true, LIU
+	case "lien":
+		return true, LIAN
+	case "lin":
+		return true, LIN
+	case "liang":
+		return true, LIANG
+	case "ling":
+		return true, LING
+	case "lu":
+		return true, LU
+	case "lo":
+		return true, LUO
+	case "luan":
+		return true, LUAN
+	case "lun":
+		return true, LUN
+	case "lü":
+		return true, LÜ
+	case "lüeh":
+		return true, LÜE
+	case "ka":
+		return true, GA
+	case "ko":
+		return true, GE
+	case "kai":
+		return true, GAI
+	case "kei":
+		return true, GEI
+	case "kao":
+		return true, GAO
+	case "kou":
+		return true, GOU
+	case "kan":
+		return true, GAN
+	case "kên":
+		return true, GEN
+	case "kang":
+		return true, GANG
+	case "kêng":
+		return true, GENG
+	case "kung":
+		return true, GONG
+	case "ku":
+		return true, GU
+	case "kua":
+		return true, GUA
+	case "kuo":
+		return true, GUO
+	case "kuai":
+		return true, GUAI
+	case "kuei":
+		return true, GUI
+	case "kuan":
+		return true, GUAN
+	case "kun":
+		return true, GUN
+	case "kuang":
+		return true, GUANG
+	case "k'a":
+		return true, KA
+	case "k'o":
+		return true, KE
+	case "k'ai":
+		return true, KAI
+	case "k'ei":
+		return true, KEI
+	case "k'ao":
+		return true, KAO
+	case "k'ou":
+		return true, KOU
+	case "k'an":
+		return true, KAN
+	case "k'ên":
+		return true, KEN
+	case "k'ang":
+		return true, KANG
+	case "k'êng":
+		return true, KENG
+	case "k'ung":
+		return true, KONG
+	case "k'u":
+		return true, KU
+	case "k'ua":
+		return true, KUA
+	case "k'uo":
+		return true, KUO
+	case "k'uai":
+		return true, KUAI
+	case "k'uei":
+		return true, KUI
+	case "k'uan":
+		return true, KUAN
+	case "k'un":
+		return true, KUN
+	case "k'uang":
+		return true, KUANG
+	case "ha":
+		return true, HA
+	case "ho":
+		return true, HE
+	case "hai":
+		return true, HAI
+	case "hei":
+		return true, HEI
+	case "hao":
+		return true, HAO
+	case "hou":
+		return true, HOU
+	case "han":
+		return true, HAN
+	case "hên":
+		return true, HEN
+	case "hang":
+		return true, HANG
+	case "hêng":
+		return true, HENG
+	case "hung":
+		return true, HONG
+	case "hu":
+		return true, HU
+	case "hua":
+		return true, HUA
+	case "huo":
+		return true, HUO
+	case "huai":
+		return true, HUAI
+	case "hui":
+		return true, HUI
+	case "huan":
+		return true, HUAN
+	case "hun":
+		return true, HUN
+	case "huang":
+		return true, HUANG
+	case "tsa":
+		return true, ZA
+	case "tsê":
+		return true, ZE
+	case "tzŭ":
+		return true, ZI
+	case "tsai":
+		return true, ZAI
+	case "tsei":
+		return true, ZEI
+	case "tsao":
+		return true, ZAO
+	case "tsou":
+		return true, ZOU
+	case "tsan":
+		return true, ZAN
+	case "tsên":
+		return true, ZEN
+	case "tsang":
+		return true, ZANG
+	case "tsêng":
+		return true, ZENG
+	case "tsung":
+		return true, ZONG
+	case "tsu":
+		return true, ZU
+	case "tso":
+		return true, ZUO
+	case "tsui":
+		return true, ZUI
+	case "tsuan":
+		return true, ZUAN
+	case "tsun":
+		return true, ZUN
+	case "ts'a":
+		return true, CA
+	case "ts'ê":
+		return true, CE
+	case "tz'ŭ":
+		return true, CI
+	case "ts'ai":
+		return true, CAI
+	case "ts'ao":
+		return true, CAO
+	case "ts'ou":
+		return true, COU
+	case "ts'an":
+		return true, CAN
+	case "ts'ên":
+		return true, CEN
+	case "ts'ang":
+		return true, CANG
+	case "ts'êng":
+		return true, CENG
+	case "ts'ung":
+		return true, CONG
+	case "ts'u":
+		return true, CU
+	case "ts'o":
+		return true, CUO
+	case "ts'ui":
+		return true, CUI
+	case "ts'uan":
+		return true, CUAN
+	case "ts'un":
+		return true, CUN
+	case "sa":
+		return true, SA
+	case "sê":
+		return true, SE
+	case "ssŭ":
+		return true, SI
+	case "sai":
+		return true, SAI
+	case "sao":
+		return true, SAO
+	case "sou":
+		return true, SOU
+	case "san":
+		return true, SAN
+	case "sên":
+		return true, SEN
+	case "sang":
+		return true, SANG
+	case "sêng":
+		return true, SENG
+	case "sung":
+		return true, SONG
+	case "su":
+		return true, SU
+	case "so":
+		return true, SUO
+	case "sui":
+		return true, SUI
+	case "suan":
+		return true, SUAN
+	case "sun":
+		return true, SUN
+	case "cha":
+		return true, ZHA
+	case "chê":
+		return true, ZHE
+	case "chih":
+		return true, ZHI
+	case "chai":
+		return true, ZHAI
+	case "chei":
+		return true, ZHEI
+	case "chao":
+		return true, ZHAO
+	case "chou":
+		return true, ZHOU
+	case "chan":
+		return true, ZHAN
+	case "chên":
+		return true, ZHEN
+	case "chang":
+		return true, ZHANG
+	case "chêng":
+		return true, ZHENG
+	case "chung":
+		return true, ZHONG
+	case "chu":
+		return true, ZHU
+	case "chua":
+		return true, ZHUA
+	case "cho":
+		return true, ZHUO
+	case "chuai":
+		return true, ZHUAI
+	case "chui":
+		return true, ZHUI
+	case "chuan":
+		return true, ZHUAN
+	case "chun":
+		return true, ZHUN
+	case "chuang":
+		return true, ZHUANG
+	case "ch'a":
+		return true, CHA
+	case "ch'ê":
+		return true, CHE
+	case "ch'ih":
+		return true, CHI
+	case "ch'ai":
+		return true, CHAI
+	case "ch'ao":
+		return true, CHAO
+	case "ch'ou":
+		return true, CHOU
+	case "ch'an":
+		return true, CHAN
+	case "ch'ên":
+		return true, CHEN
+	case "ch'ang":
+		return true, CHANG
+	case "ch'êng":
+		return true, CHENG
+	case "ch'ung":
+		return true, CHONG
+	case "ch'u":
+		return true, CHU
+	case "ch'ua":
+		return true, CHUA
+	case "ch'o":
+		return true, CHUO
+	case "ch'uai":
+		return true, CHUAI
+	case "ch'ui":
+		return true, CHUI
+	case "ch'uan":
+		return true, CHUAN
+	case "ch'un":
+		return true, CHUN
+	case "ch'uang":
+		return true, CHUANG
+	case "sha":
+		return true, SHA
+	case "shê":
+		return true, SHE
+	case "shih":
+		return true, SHI
+	case "shai":
+		return true, SHAI
+	case "shei":
+		return true, SHEI
+	case "shao":
+		return true, SHAO
+	case "shou":
+		return true, SHOU
+	case "shan":
+		return true, SHAN
+	case "shên":
+		return true, SHEN
+	case "shang":
+		return true, SHANG
+	case "shêng":
+		return true, SHENG
+	case "shu":
+		return true, SHU
+	case "shua":
+		return true, SHUA
+	case "shuo":
+		return true, SHUO
+	case "shuai":
+		return true, SHUAI
+	case "shui":
+		return true, SHUI
+	case "shuan":
+		return true, SHUAN
+	case "shun":
+		return true, SHUN
+	case "shuang":
+		return true, SHUANG
+	case "jê":
+		return true, RE
+	case "jih":
+		return true, RI
+	case "jao":
+		return true, RAO
+	case "jou":
+		return true, ROU
+	case "jan":
+		return true, RAN
+	case "jên":
+		return true, REN
+	case "jang":
+		return true, RANG
+	case "jêng":
+		return true, RENG
+	case "jung":
+		return true, RONG
+	case "ju":
+		return true, RU
+	case "jua":
+		return true, RUA
+	case "jo":
+		return true, RUO
+	case "jui":
+		return true, RUI
+	case "juan":
+		return true, RUAN
+	case "jun":
+		return true, RUN
+	case "chi":
+		return true, JI
+	case "chia":
+		return true, JIA
+	case "chiao":
+		return true, JIAO
+	case "chieh":
+		return true, JIE
+	case "chiu":
+		return true, JIU
+	case "chien":
+		return true, JIAN
+	case "chin":
+		return true, JIN
+	case "chiang":
+		return true, JIANG
+	case "ching":
+		return true, JING
+	case "chiung":
+		return true, JIONG
+	case "chü":
+		return true, JU
+	case "chüeh":
+		return true, JUE
+	case "chüan":
+		return true, JUAN
+	case "chün":
+		return true, JUN
+	case "ch'i":
+		return true, QI
+	case "ch'ia":
+		return true, QIA
+	case "ch'iao":
+		return true, QIAO
+	case "ch'ieh":
+		return true, QIE
+	case "ch'iu":
+		return true, QIU
+	case "ch'ien":
+		return true, QIAN
+	case "ch'in":
+		return true, QIN
+	case "ch'iang":
+		return true, QIANG
+	case "ch'ing":
+		return true, QING
+	case "ch'iung":
+		return true, QIONG
+	case "ch'ü":
+		return true, QU
+	case "ch'üeh":
+		return true, QUE
+	case "ch'üan":
+		return true, QUAN
+	case "ch'ün":
+		return true, QUN
+	case "hsi":
+		return true, XI
+	case "hsia":
+		return true, XIA
+	case "hsiao":
+		return true, XIAO
+	case "hsieh":
+		return true, XIE
+	case "hsiu":
+		return true, XIU
+	case "hsien":
+		return true, XIAN
+	case "hsin":
+		return true, XIN
+	case "hsiang":
+		return true, XIANG
+	case "hsing":
+		return true, XING
+	case "hsiung":
+		return true, XIONG
+	case "hsü":
+		return true, XU
+	case "hsüeh":
+		return true, XUE
+	case "hsüan":
+		return true, XUAN
+	case "hsün":
+		return true, XUN
+	case "fiao":
+		return true, FIAO
+	case "n":
+		return true, N
+	case "ng":
+		return true, NG
+	case "m":
+		return true, M
+	case "yo":
+		return true, YO
+	case "hm":
+		return true, HM
+	case "ei":
+		return true, EI
+	case "nun":
+		return true, NUN
+	case "ts'ei":
+		return true, CEI
+	case "tin":
+		return true, DIN
+	case "piang":
+		return true, BIANG
+	case "e":
+		return true, E
+	case "erh":
+		return true, ER
+	case "en":
+		return true, EN
+	case "eng":
+		return true, ENG
+	case "wen":
+		return true, WEN
+	case "weng":
+		return true, WENG
+	case "pen":
+		return true, BEN
+	case "peng":
+		return true, BENG
+	case "p'en":
+		return true, PEN
+	case "p'eng":
+		return true, PENG
+	case "me":
+		return true, ME
+	case "men":
+		return true, MEN
+	case "meng":
+		return true, MENG
+	case "fen":
+		return true, FEN
+	case "feng":
+		return true, FENG
+	case "te":
+		return true, DE
+	case "ten":
+		return true, DEN
+	case "teng":
+		return true, DENG
+	case "t'e":
+		return true, TE
+	case "t'eng":
+		return true, TENG
+	case "ne":
+		return true, NE
+	case "nen":
+		return true, NEN
+	case "neng":
+		return true, NENG
+	case "le":
+		return true, LE
+	case "leng":
+		return true, LENG
+	case "ken":
+		return true, GEN
+	case "keng":
+		return true, GENG
+	case "k'en":
+		return true, KEN
+	case "k'eng":
+		return true, KENG
+	case "hen":
+		return true, HEN
+	case "heng":
+		return true, HENG
+	case "tse":
+		return true, ZE
+	case "tzu":
+		return true, ZI
+	case "tsen":
+		return true, ZEN
+	case "tseng":
+		return true, ZENG
+	case "ts'e":
+		return true, CE
+	case "tz'u":
+		return true, CI
+	case "ts'en":
+		return true, CEN
+	case "ts'eng":
+		return true, CENG
+	case "se":
+		return true, SE
+	case "ssu":
+		return true, SI
+	case "szu":
+		return true, SI
+	case "sen":
+		return true, SEN
+	case "seng":
+		return true, SENG
+	case "che":
+		return true, ZHE
+	case "chen":
+		return true, ZHEN
+	case "cheng":
+		return true, ZHENG
+	case "ch'e":
+		return true, CHE
+	case "ch'en":
+		return true, CHEN
+	case "ch'eng":
+		return true, CHENG
+	case "she":
+		return true, SHE
+	case "shen":
+		return true, SHEN
+	case "sheng":
+		return true, SHENG
+	case "je":
+		return true, RE
+	case "jen":
+		return true, REN
+	case "jeng":
+		return true, RENG
+	default:
+		return false, 0
+	}
+}
+
+const maxWadeGilesLength = 7
+
+func (p Sound) yale() string {
+	switch p {
+	case A:
+		return "a"
+	case O:
+		return "o"
+	case E:
+		return "e"
+	case ER:
+		return "er"
+	case AI:
+		return "ai"
+	case AO:
+		return "au"
+	case OU:
+		return "ou"
+	case AN:
+		return "an"
+	case EN:
+		return "en"
+	case ANG:
+		return "ang"
+	case ENG:
+		return "eng"
+	case YI:
+		return "yi"
+	case YA:
+		return "ya"
+	case YAO:
+		return "yau"
+	case YE:
+		return "ye"
+	case YOU:
+		return "you"
+	case YAN:
+		return "yan"
+	case YIN:
+		return "yin"
+	case YANG:
+		return "yang"
+	case YING:
+		return "ying"
+	case YONG:
+		return "yung"
+	case WU:
+		return "wu"
+	case WA:
+		return "wa"
+	case WO:
+		return "wo"
+	case WAI:
+		return "wai"
+	case WEI:
+		return "wei"
+	case WAN:
+		return "wan"
+	case WEN:
+		return "wen"
+	case WANG:
+		return "wang"
+	case WENG:
+		return "weng"
+	case YU:
+		return "yu"
+	case YUE:
+		return "ywe"
+	case YUAN:
+		return "ywan"
+	case YUN:
+		return "yun"
+	case BA:
+		return "ba"
+	case BO:
+		return "bo"
+	case BAI:
+		return "bai"
+	case BEI:
+		return "bei"
+	case BAO:
+		return "bau"
+	case BAN:
+		return "ban"
+	case BEN:
+		return "ben"
+	case BANG:
+		return "bang"
+	case BENG:
+		return "beng"
+	case BI:
+		return "bi"
+	case BIAO:
+		return "byau"
+	case BIE:
+		return "bye"
+	case BIAN:
+		return "byan"
+	case BIN:
+		return "bin"
+	case BING:
+		return "bing"
+	case BU:
+		return "bu"
+	case PA:
+		return "pa"
+	case PO:
+		return "po"
+	case PAI:
+		return "pai"
+	case PEI:
+		return "pei"
+	case PAO:
+		return "pau"
+	case POU:
+		return "pou"
+	case PAN:
+		return "pan"
+	case PEN:
+		return "pen"
+	case PANG:
+		return "pang"
+	case PENG:
+		return "peng"
+	case PI:
+		return "pi"
+	case PIAO:
+		return "pyau"
+	case PIE:
+		return "pye"
+	case PIAN:
+		return "pyan"
+	case PIN:
+		return "pin"
+	case PING:
+		return "ping"
+	case PU:
+		return "pu"
+	case MA:
+		return "ma"
+	case MO:
+		return "mo"
+	case ME:
+		return "me"
+	case MAI:
+		return "mai"
+	case MEI:
+		return "mei"
+	case MAO:
+		return "mau"
+	case MOU:
+		return "mou"
+	case MAN:
+		return "man"
+	case MEN:
+		return "men"
+	case MANG:
+		return "mang"
+	case MENG:
+		return "meng"
+	case MI:
+		return "mi"
+	case MIAO:
+		return "myau"
+	case MIE:
+		return "mye"
+	case MIU:
+		return "myou"
+	case MIAN:
+		return "myan"
+	case MIN:
+		return "min"
+	case MING:
+		return "ming"
+	case MU:
+		return "mu"
+	case FA:
+		return "fa"
+	case FO:
+		return "fo"
+	case FEI:
+		return "fei"
+	case FOU:
+		return "fou"
+	case FAN:
+		return "fan"
+	case FEN:
+		return "fen"
+	case FANG:
+		return "fang"
+	case FENG:
+		return "feng"
+	case FU:
+		return "fu"
+	case DA:
+		return "da"
+	case DE:
+		return "de"
+	case DAI:
+		return "dai"
+	case DEI:
+		return "dei"
+	case DAO:
+		return "dau"
+	case DOU:
+		return "dou"
+	case DAN:
+		return "dan"
+	case DEN:
+		return "den"
+	case DANG:
+		return "dang"
+	case DENG:
+		return "deng"
+	case DONG:
+		return "dung"
+	case DI:
+		return "di"
+	case DIAO:
+		return "dyau"
+	case DIE:
+		return "dye"
+	case DIU:
+		return "dyou"
+	case DIAN:
+		return "dyan"
+	case DING:
+		return "ding"
+	case DU:
+		return "du"
+	case DUO:
+		return "dwo"
+	case DUI:
+		return "dwei"
+	case DUAN:
+		return "dwan"
+	case DUN:
+		return "dwun"
+	case TA:
+		return "ta"
+	case TE:
+		return "te"
+	case TAI:
+		return "tai"
+	case TEI:
+		return "tei"
+	case TAO:
+		return "tau"
+	case TOU:
+		return "tou"
+	case TAN:
+		return "tan"
+	case TANG:
+		return "tang"
+	case TENG:
+		return "teng"
+	case TONG:
+		return "tung"
+	case TI:
+		return "ti"
+	case TIAO:
+		return "tyau"
+	case TIE:
+		return "tye"
+	case TIAN:
+		return "tyan"
+	case TING:
+		return "ting"
+	case TU:
+		return "tu"
+	case TUO:
+		return "two"
+	case TUI:
+		return "twei"
+	case TUAN:
+		return "twan"
+	case TUN:
+		return "twun"
+	case NA:
+		return "na"
+	case NE:
+		return "ne"
+	case NAI:
+		return "nai"
+	case NEI:
+		return "nei"
+	case NAO:
+		return "nau"
+	case NOU:
+		return "nou"
+	case NAN:
+		return "nan"
+	case NEN:
+		return "nen"
+	case NANG:
+		return "nang"
+	case NENG:
+		return "neng"
+	case NONG:
+		return "nung"
+	case NI:
+		return "ni"
+	case NIAO:
+		return "nyau"
+	case NIE:
+		return "nye"
+	case NIU:
+		return "nyou"
+	case NIAN:
+		return "nyan"
+	case NIN:
+		return "nin"
+	case NIANG:
+		return "nyang"
+	case NING:
+		return "ning"
+	case NU:
+		return "nu"
+	case NUO:
+		return "nwo"
+	case NUAN:
+		return "nwan"
+	case NÜ:
+		return "nyu"
+	case NÜE:
+		return "nywe"
+	case LA:
+		return "la"
+	case LE:
+		return "le"
+	case LAI:
+		return "lai"
+	case LEI:
+		return "lei"
+	case LAO:
+		return "lau"
+	case LOU:
+		return "lou"
+	case LAN:
+		return "lan"
+	case LANG:
+		return "lang"
+	case LENG:
+		return "leng"
+	case LONG:
+		return "lung"
+	case LI:
+		return "li"
+	case LIA:
+		return "lya"
+	case LIAO:
+		return "lyau"
+	case LIE:
+		return "lye"
+	case LIU:
+		return "lyou"
+	case LIAN:
+		return "lyan"
+	case LIN:
+		return "lin"
+	case LIANG:
+		return "lyang"
+	case LING:
+		return "ling"
+	case LU:
+		return "lu"
+	case LUO:
+		return "lwo"
+	case LUAN:
+		return "lwan"
+	case LUN:
+		return "lwun"
+	case LÜ:
+		return "lyu"
+	case LÜE:
+		return "lywe"
+	case GA:
+		return "ga"
+	case GE:
+		return "ge"
+	case GAI:
+		return "gai"
+	case GEI:
+		return "gei"
+	case GAO:
+		return "gau"
+	case GOU:
+		return "gou"
+	case GAN:
+		return "gan"
+	case GEN:
+		return "gen"
+	case GANG:
+		return "gang"
+	case GENG:
+		return "geng"
+	case GONG:
+		return "gung"
+	case GU:
+		return "gu"
+	case GUA:
+		return "gwa"
+	case GUO:
+		return "gwo"
+	case GUAI:
+		return "gwai"
+	case GUI:
+		return "gwei"
+	case GUAN:
+		return "gwan"
+	case GUN:
+		return "gwun"
+	case GUANG:
+		return "gwang"
+	case KA:
+		return "ka"
+	case KE:
+		return "ke"
+	case KAI:
+		return "kai"
+	case KEI:
+		return "kei"
+	case KAO:
+		return "kau"
+	case KOU:
+		return "kou"
+	case KAN:
+		return "kan"
+	case KEN:
+		return "ken"
+	case KANG:
+		return "kang"
+	case KENG:
+		return "keng"
+	case KONG:
+		return "kung"
+	case KU:
+		return "ku"
+	case KUA:
+		return "kwa"
+	case KUO:
+		return "kwo"
+	case KUAI:
+		return "kwai"
+	case KUI:
+		return "kwei"
+	case KUAN:
+		return "kwan"
+	case KUN:
+		return "kwun"
+	case KUANG:
+		return "kwang"
+	case HA:
+		return "ha"
+	case HE:
+		return "he"
+	case HAI:
+		return "hai"
+	case HEI:
+		return "hei"
+	case HAO:
+		return "hau"
+	case HOU:
+		return "hou"
+	case HAN:
+		return "han"
+	case HEN:
+		return "hen"
+	case HANG:
+		return "hang"
+	case HENG:
+		return "heng"
+	case HONG:
+		return "hung"
+	case HU:
+		return "hu"
+	case HUA:
+		return "hwa"
+	case HUO:
+		return "hwo"
+	case HUAI:
+		return "hwai"
+	case HUI:
+		return "hwei"
+	case HUAN:
+		return "hwan"
+	case HUN:
+		return "hwun"
+	case HUANG:
+		return "hwang"
+	case ZA:
+		return "dza"
+	case ZE:
+		return "dze"
+	case ZI:
+		return "dz"
+	case ZAI:
+		return "dzai"
+	case ZEI:
+		return "dzei"
+	case ZAO:
+		return "dzau"
+	case ZOU:
+		return "dzou"
+	case ZAN:
+		return "dzan"
+	case ZEN:
+		return "dzen"
+	case ZANG:
+		return "dzang"
+	case ZENG:
+		return "dzeng"
+	case ZONG:
+		return "dzung"
+	case ZU:
+		return "dzu"
+	case ZUO:
+		return "dzwo"
+	case ZUI:
+		return "dzwei"
+	case ZUAN:
+		return "dzwan"
+	case ZUN:
+		return "dzwun"
+	case CA:
+		return "tsa"
+	case CE:
+		return "tse"
+	case CI:
+		return "tsz"
+	case CAI:
+		return "tsai"
+	case CAO:
+		return "tsau"
+	case COU:
+		return "tsou"
+	case CAN:
+		return "tsan"
+	case CEN:
+		return "tsen"
+	case CANG:
+		return "tsang"
+	case CENG:
+		return "tseng"
+	case CONG:
+		return "tsung"
+	case CU:
+		return "tsu"
+	case CUO:
+		return "tswo"
+	case CUI:
+		return "tswei"
+	case CUAN:
+		return "tswan"
+	case CUN:
+		return "tswun"
+	case SA:
+		return "sa"
+	case SE:
+		return "se"
+	case SI:
+		return "sz"
+	case SAI:
+		return "sai"
+	case SAO:
+		return "sau"
+	case SOU:
+		return "sou"
+	case SAN:
+		return "san"
+	case SEN:
+		return "sen"
+	case SANG:
+		return "sang"
+	case SENG:
+		return "seng"
+	case SONG:
+		return "sung"
+	case SU:
+		return "su"
+	case SUO:
+		return "swo"
+	case SUI:
+		return "swei"
+	case SUAN:
+		return "swan"
+	case SUN:
+		return "swun"
+	case ZHA:
+		return "ja"
+	case ZHE:
+		return "je"
+	case ZHI:
+		return "jr"
+	case ZHAI:
+		return "jai"
+	case ZHEI:
+		return "jei"
+	case ZHAO:
+		return "jau"
+	case ZHOU:
+		return "jou"
+	case ZHAN:
+		return "jan"
+	case ZHEN:
+		return "jen"
+	case ZHANG:
+		return "jang"
+	case ZHENG:
+		return "jeng"
+	case ZHONG:
+		return "jung"
+	case ZHU:
+		return "ju"
+	case ZHUA:
+		return "jwa"
+	case ZHUO:
+		return "jwo"
+	case ZHUAI:
+		return "jwai"
+	case ZHUI:
+		return "jwei"
+	case ZHUAN:
+		return "jwan"
+	case ZHUN:
+		return "jwun"
+	case ZHUANG:
+		return "jwang"
+	case CHA:
+		return "cha"
+	case CHE:
+		return "che"
+	case CHI:
+		return "chr"
+	case CHAI:
+		return "chai"
+	case CHAO:
+		return "chau"
+	case CHOU:
+		return "chou"
+	case CHAN:
+		return "chan"
+	case CHEN:
+		return "chen"
+	case CHANG:
+		return "chang"
+	case CHENG:
+		return "cheng"
+	case CHONG:
+		return "chung"
+	case CHU:
+		return "chu"
+	case CHUA:
+		return "chwa"
+	case CHUO:
+		return "chwo"
+	case CHUAI:
+		return "chwai"
+	case CHUI:
+		return "chwei"
+	case CHUAN:
+		return "chwan"
+	case CHUN:
+		return "chwun"
+	case CHUANG:
+		return "chwang"
+	case SHA:
+		return "sha"
+	case SHE:
+		return "she"
+	case SHI:
+		return "shr"
+	case SHAI:
+		return "shai"
+	case SHEI:
+		return "shei"
+	case SHAO:
+		return "shau"
+	case SHOU:
+		return "shou"
+	case SHAN:
+		return "shan"
+	case SHEN:
+		return "shen"
+	case SHANG:
+		return "shang"
+	case SHENG:
+		return "sheng"
+	case SHU:
+		return "shu"
+	case SHUA:
+		return "shwa"
+	case SHUO:
+		return "shwo"
+	case SHUAI:
+		return "shwai"
+	case SHUI:
+		return "shwei"
+	case SHUAN:
+		return "shwan"
+	case SHUN:
+		return "shwun"
+	case SHUANG:
+		return "shwang"
+	case RE:
+		return "re"
+	case RI:
+		return "r"
+	case RAO:
+		return "rau"
+	case ROU:
+		return "rou"
+	case RAN:
+		return "ran"
+	case REN:
+		return "ren"
+	case RANG:
+		return "rang"
+	case RENG:
+		return "reng"
+	case RONG:
+		return "rung"
+	case RU:
+		return "ru"
+	case RUA:
+		return "rwa"
+	case RUO:
+		return "rwo"
+	case RUI:
+		return "rwei"
+	case RUAN:
+		return "rwan"
+	case RUN:
+		return "rwun"
+	case JI:
+		return "ji"
+	case JIA:
+		return "jya"
+	case JIAO:
+		return "jyau"
+	case JIE:
+		return "jye"
+	case JIU:
+		return "jyou"
+	case JIAN:
+		return "jyan"
+	case JIN:
+		return "jin"
+	case JIANG:
+		return "jyang"
+	case JING:
+		return "jing"
+	case JIONG:
+		return "jyung"
+	case JU:
+		return "jyu"
+	case JUE:
+		return "jywe"
+	case JUAN:
+		return "jywan"
+	case JUN:
+		return "jyun"
+	case QI:
+		return "chi"
+	case QIA:
+		return "chya"
+	case QIAO:
+		return "chyau"
+	case QIE:
+		return "chye"
+	case QIU:
+		return "chyou"
+	case QIAN:
+		return "chyan"
+	case QIN:
+		return "chin"
+	case QIANG:
+		return "chyang"
+	case QING:
+		return "ching"
+	case QIONG:
+		return "chyung"
+	case QU:
+		return "chyu"
+	case QUE:
+		return "chywe"
+	case QUAN:
+		return "chywan"
+	case QUN:
+		return "chyun"
+	case XI:
+		return "syi"
+	case XIA:
+		return "sya"
+	case XIAO:
+		return "syau"
+	case XIE:
+		return "sye"
+	case XIU:
+		return "syou"
+	case XIAN:
+		return "syan"
+	case XIN:
+		return "syin"
+	case XIANG:
+		return "syang"
+	case XING:
+		return "sying"
+	case XIONG:
+		return "syung"
+	case XU:
+		return "syu"
+	case XUE:
+		return "sywe"
+	case XUAN:
+		return "sywan"
+	case XUN:
+		return "syun"
+	case FIAO:
+		return "fyau"
+	case N:
+		return "n"
+	case NG:
+		return "ng"
+	case M:
+		return "m"
+	case YO:
+		return "yo"
+	case HM:
+		return "hm"
+	case LO:
+		return "lo"
+	case EI:
+		return "ei"
+	case NUN:
+		return "nwun"
+	case CEI:
+		return "tsei"
+	case WONG:
+		return "weng"
+	case DIN:
+		return "din"
+	case BIANG:
+		return "byang"
+	case R:
+		return "r"
+	default:
+		return "?"
+	}
+}
+
+func yaleSound(str string) (bool, Sound) {
+	switch str {
+	case "a":
+		return true, A
+	case "o":
+		return true, O
+	case "e":
+		return true, E
+	case "er":
+		return true, ER
+	case "ai":
+		return true, AI
+	case "au":
+		return true, AO
+	case "ou":
+		return true, OU
+	case "an":
+		return true, AN
+	case "en":
+		return true, EN
+	case "ang":
+		return true, ANG
+	case "eng":
+		return true, ENG
+	case "yi":
+		return true, YI
+	case "ya":
+		return true, YA
+	case "yau":
+		return true, YAO
+	case "ye":
+		return true, YE
+	case "you":
+		return true, YOU
+	case "yan":
+		return true, YAN
+	case "yin":
+		return true, YIN
+	case "yang":
+		return true, YANG
+	case "ying":
+		return true, YING
+	case "yung":
+		return true, YONG
+	case "wu":
+		return true, WU
+	case "wa":
+		return true, WA
+	case "wo":
+		return true, WO
+	case "wai":
+		return true, WAI
+	case "wei":
+		return true, WEI
+	case "wan":
+		return true, WAN
+	case "wen":
+		return true, WEN
+	case "wang":
+		return true, WANG
+	case "weng":
+		return true, WENG
+	case "yu":
+		return true, YU
+	case "ywe":
+		return true, YUE
+	case "ywan":
+		return true, YUAN
+	case "yun":
+		return true, YUN
+	case "ba":
+		return true, BA
+	case "bo":
+		return true, BO
+	case "bai":
+		return true, BAI
+	case "bei":
+		return true, BEI
+	case "bau":
+		return true, BAO
+	case "ban":
+		return true, BAN
+	case "ben":
+		return true, BEN
+	case "bang":
+		return true, BANG
+	case "beng":
+		return true, BENG
+	case "bi":
+		return true, BI
+	case "byau":
+		return true, BIAO
+	case "bye":
+		return true, BIE
+	case "byan":
+		return true, BIAN
+	case "bin":
+		return true, BIN
+	case "bing":
+		return true, BING
+	case "bu":
+		return true, BU
+	case "pa":
+		return true, PA
+	case "po":
+		return true, PO
+	case "pai":
+		return true, PAI
+	case "pei":
+		return true, PEI
+	case "pau":
+		return true, PAO
+	case "pou":
+		return true, POU
+	case "pan":
+		return true, PAN
+	case "pen":
+		return true, PEN
+	case "pang":
+		return true, PANG
+	case "peng":
+		return true, PENG
+	case "pi":
+		return true, PI
+	case "pyau":
+		return true, PIAO
+	case "pye":
+		return true, PIE
+	case "pyan":
+		return true, PIAN
+	case "pin":
+		return true, PIN
+	case "ping":
+		return true, PING
+	case "pu":
+		return true, PU
+	case "ma":
+		return true, MA
+	case "mo":
+		return true, MO
+	case "me":
+		return true, ME
+	case "mai":
+		return true, MAI
+	case "mei":
+		return true, MEI
+	case "mau":
+		return true, MAO
+	case "mou":
+		return true, MOU
+	case "man":
+		return true, MAN
+	case "men":
+		return true, MEN
+	case "mang":
+		return true, MANG
+	case "meng":
+		return true, MENG
+	case "mi":
+		return true, MI
+	case "myau":
+		return true, MIAO
+	case "mye":
+		return true, MIE
+	case "myou":
+		return true, MIU
+	case "myan":
+		return true, MIAN
+	case "min":
+		return true, MIN
+	case "ming":
+		return true, MING
+	case "mu":
+		return true, MU
+	case "fa":
+		return true, FA
+	case "fo":
+		return true, FO
+	case "fei":
+		return true, FEI
+	case "fou":
+		return true, FOU
+	case "fan":
+		return true, FAN
+	case "fen":
+		return true, FEN
+	case "fang":
+		return true, FANG
+	case "feng":
+		return true, FENG
+	case "fu":
+		return true, FU
+	case "da":
+		return true, DA
+	case "de":
+		return true, DE
+	case "dai":
+		return true, DAI
+	case "dei":
+		return true, DEI
+	case "dau":
+		return true, DAO
+	case "dou":
+		return true, DOU
+	case "dan":
+		return true, DAN
+	case "den":
+		return true, DEN
+	case "dang":
+		return true, DANG
+	case "deng":
+		return true, DENG
+	case "dung":
+		return true, DONG
+	case "di":
+		return true, DI
+	case "dyau":
+		return true, DIAO
+	case "dye":
+		return true, DIE
+	case "dyou":
+		return true, DIU
+	case "dyan":
+		return true, DIAN
+	case "ding":
+		return true, DING
+	case "du":
+		return true, DU
+	case "dwo":
+		return true, DUO
+	case "dwei":
+		return true, DUI
+	case "dwan":
+		return true, DUAN
+	case "dwun":
+		return true, DUN
+	case "ta":
+		return true, TA
+	case "te":
+		return true, TE
+	case "tai":
+		return true, TAI
+	case "tei":
+		return true, TEI
+	case "tau":
+		return true, TAO
+	case "tou":
+		return true, TOU
+	case "tan":
+		return true, TAN
+	case "tang":
+		return true, TANG
+	case "teng":
+		return true, TENG
+	case "tung":
+		return true, TONG
+	case "ti":
+		return true, TI
+	case "tyau":
+		return true, TIAO
+	case "tye":
+		return true, TIE
+	case "tyan":
+		return true, TIAN
+	case "ting":
+		return true, TING
+	case "tu":
+		return true, TU
+	case "two":
+		return true, TUO
+	case "twei":
+		return true, TUI
+	case "twan":
+		return true, TUAN
+	case "twun":
+		return true, TUN
+	case "na":
+		return true, NA
+	case "ne":
+		return true, NE
+	case "nai":
+		return true, NAI
+	case "nei":
+		return true, NEI
+	case "nau":
+		return true, NAO
+	case "nou":
+		return true, NOU
+	case "nan":
+		return true, NAN
+	case "nen":
+		return true, NEN
+	case "nang":
+		return true, NANG
+	case "neng":
+		return true, NENG
+	case "nung":
+		return true, NONG
+	case "ni":
+		return true, NI
+	case "nyau":
+		return true, NIAO
+	case "nye":
+		return true, NIE
+	case "nyou":
+		return true, NIU
+	case "nyan":
+		return true, NIAN
+	case "nin":
+		return true, NIN
+	case "nyang":
+		return true, NIANG
+	case "ning":
+		return true, NING
+	case "nu":
+		return true, NU
+	case "nwo":
+		return true, NUO
+	case "nwan":
+		return true, NUAN
+	case "nyu":
+		return true, NÜ
+	case "nywe":
+		return true, NÜE
+	case "la":
+		return true, LA
+	case "le":
+		return true, LE
+	case "lai":
+		return true, LAI
+	case "lei":
+		return true, LEI
+	case "lau":
+		return true, LAO
+	case "lou":
+		return true, LOU
+	case "lan":
+		return true, LAN
+	case "lang":
+		return true, LANG
+	case "leng":
+		return true, LENG
+	case "lung":
+		return true, LONG
+	case "li":
+		return true, LI
+	case "lya":
+		return true, LIA
+	case "lyau":
+		return true, LIAO
+	case "lye":
+		return true, LIE
+	case "lyou":
+		return true, LIU
+	case "lyan":
+		return true, LIAN
+	case "lin":
+		return true, LIN
+	case "lyang":
+		return true, LIANG
+	case "ling":
+		return true, LING
+	case "lu":
+		return true, LU
+	case "lwo":
+		return true, LUO
+	case "lwan":
+		return true, LUAN
+	case "lwun":
+		return true, LUN
+	case "lyu":
+		return true, LÜ
+	case "lywe":
+		return true, LÜE
+	case "ga":
+		return true, GA
+	case "ge":
+		return true, GE
+	case "gai":
+		return true, GAI
+	case "gei":
+		return true, GEI
+	case "gau":
+		return true, GAO
+	case "gou":
+		return true, GOU
+	case "gan":
+		return true, GAN
+	case "gen":
+		return true, GEN
+	case "gang":
+		return true, GANG
+	case "geng":
+		return true, GENG
+	case "gung":
+		return true, GONG
+	case "gu":
+		return true, GU
+	case "gwa":
+		return true, GUA
+	case "gwo":
+		return true, GUO
+	case "gwai":
+		return true, GUAI
+	case "gwei":
+		return true, GUI
+	case "gwan":
+		return true, GUAN
+	case "gwun":
+		return true, GUN
+	case "gwang":
+		return true, GUANG
+	case "ka":
+		return true, KA
+	case "ke":
+		return true, KE
+	case "kai":
+		return true, KAI
+	case "kei":
+		return true, KEI
+	case "kau":
+		return true, KAO
+	case "kou":
+		return true, KOU
+	case "kan":
+		return true, KAN
+	case "ken":
+		return true, KEN
+	case "kang":
+		return true, KANG
+	case "keng":
+		return true, KENG
+	case "kung":
+		return true, KONG
+	case "ku":
+		return true, KU
+	case "kwa":
+		return true, KUA
+	case "kwo":
+		return true, KUO
+	case "kwai":
+		return true, KUAI
+	case "kwei":
+		return true, KUI
+	case "kwan":
+		return true, KUAN
+	case "kwun":
+		return true, KUN
+	case "kwang":
+		return true, KUANG
+	case "ha":
+		return true, HA
+	case "he":
+		return true, HE
+	case "hai":
+		return true, HAI
+	case "hei":
+		return true, HEI
+	case "hau":
+		return true, HAO
+	case "hou":
+		return true, HOU
+	case "han":
+		return true, HAN
+	case "hen":
+		return true, HEN
+	case "hang":
+		return true, HANG
+	case "heng":
+		return true, HENG
+	case "hung":
+		return true, HONG
+	case "hu":
+		return true, HU
+	case "hwa":
+		return true, HUA
+	case "hwo":
+		return true, HUO
+	case "hwai":
+		return true, HUAI
+	case "hwei":
+		return true, HUI
+	case "hwan":
+		return true, HUAN
+	case "hwun":
+		return true, HUN
+	case "hwang":
+		return true, HUANG
+	case "dza":
+		return true, ZA
+	case "dze":
+		return true, ZE
+	case "dz":
+		return true, ZI
+	case "dzai":
+		return true, ZAI
+	case "dzei":
+		return true, ZEI
+	case "dzau":
+		return true, ZAO
+	case "dzou":
+		return true, ZOU
+	case "dzan":
+		return true, ZAN
+	case "dzen":
+		return true, ZEN
+	case "dzang":
+		return true, ZANG
+	case "dzeng":
+		return true, ZENG
+	case "dzung":
+		return true, ZONG
+	case "dzu":
+		return true, ZU
+	case "dzwo":
+		return true, ZUO
+	case "dzwei":
+		return true, ZUI
+	case "dzwan":
+		return true, ZUAN
+	case "dzwun":
+		return true, ZUN
+	case "tsa":
+		return true, CA
+	case "tse":
+		return true, CE
+	case "tsz":
+		return true, CI
+	case "tsai":
+		return true, CAI
+	case "tsau":
+		return true, CAO
+	case "tsou":
+		return true, COU
+	case "tsan":
+		return true, CAN
+	case "tsen":
+		return true, CEN
+	case "tsang":
+		return true, CANG
+	case "tseng":
+		return true, CENG
+	case "tsung":
+		return true, CONG
+	case "tsu":
+		return true, CU
+	case "tswo":
+		return true, CUO
+	case "tswei":
+		return true, CUI
+	case "tswan":
+		return true, CUAN
+	case "tswun":
+		return true, CUN
+	case "sa":
+		return true, SA
+	case "se":
+		return true, SE
+	case "sz":
+		return true, SI
+	case "sai":
+		return true, SAI
+	case "sau":
+		return true, SAO
+	case "sou":
+		return true, SOU
+	case "san":
+		return true, SAN
+	case "sen":
+		return true, SEN
+	case "sang":
+		return true, SANG
+	case "seng":
+		return true, SENG
+	case "sung":
+		return true, SONG
+	case "su":
+		return true, SU
+	case "swo":
+		return true, SUO
+	case "swei":
+		return true, SUI
+	case "swan":
+		return true, SUAN
+	case "swun":
+		return true, SUN
+	case "ja":
+		return true, ZHA
+	case "je":
+		return true, ZHE
+	case "jr":
+		return true, ZHI
+	case "jai":
+		return true, ZHAI
+	case "jei":
+		return true, ZHEI
+	case "jau":
+		return true, ZHAO
+	case "jou":
+		return true, ZHOU
+	case "jan":
+		return true, ZHAN
+	case "jen":
+		return true, ZHEN
+	case "jang":
+		return true, ZHANG
+	case "jeng":
+		return true, ZHENG
+	case "jung":
+		return true, ZHONG
+	case "ju":
+		return true, ZHU
+	case "jwa":
+		return true, ZHUA
+	case "jwo":
+		return true, ZHUO
+	case "jwai":
+		return true, ZHUAI
+	case "jwei":
+		return true, ZHUI
+	case "jwan":
+		return true, ZHUAN
+	case "jwun":
+		return true, ZHUN
+	case "jwang":
+		return true, ZHUANG
+	case "cha":
+		return true, CHA
+	case "che":
+		return true, CHE
+	case "chr":
+		return true, CHI
+	case "chai":
+		return true, CHAI
+	case "chau":
+		return true, CHAO
+	case "chou":
+		return true, CHOU
+	case "chan":
+		return true, CHAN
+	case "chen":
+		return true, CHEN
+	case "chang":
+		return true, CHANG
+	case "cheng":
+		return true, CHENG
+	case "chung":
+		return true, CHONG
+	case "chu":
+		return true, CHU
+	case "chwa":
+		return true, CHUA
+	case "chwo":
+		return true, CHUO
+	case "chwai":
+		return true, CHUAI
+	case "chwei":
+		return true, CHUI
+	case "chwan":
+		return true, CHUAN
+	case "chwun":
+		return true, CHUN
+	case "chwang":
+		return true, CHUANG
+	case "sha":
+		return true, SHA
+	case "she":
+		return true, SHE
+	case "shr":
+		return true, SHI
+	case "shai":
+		return true, SHAI
+	case "shei":
+		return true, SHEI
+	case "shau":
+		return true, SHAO
+	case "shou":
+		return true, SHOU
+	case "shan":
+		return true, SHAN
+	case "shen":
+		return true, SHEN
+	case "shang":
+		return true, SHANG
+	case "sheng":
+		return true, SHENG
+	case "shu":
+		return true, SHU
+	case "shwa":
+		return true, SHUA
+	case "shwo":
+		return true, SHUO
+	case "shwai":
+		return true, SHUAI
+	case "shwei":
+		return true, SHUI
+	case "shwan":
+		return true, SHUAN
+	case "shwun":
+		return true, SHUN
+	case "shwang":
+		return true, SHUANG
+	case "re":
+		return true, RE
+	case "r":
+		return true, RI
+	case "rau":
+		return true, RAO
+	case "rou":
+		return true, ROU
+	case "ran":
+		return true, RAN
+	case "ren":
+		return true, REN
+	case "rang":
+		return true, RANG
+	case "reng":
+		return true, RENG
+	case "rung":
+		return true, RONG
+	case "ru":
+		return true, RU
+	case "rwa":
+		return true, RUA
+	case "rwo":
+		return true, RUO
+	case "rwei":
+		return true, RUI
+	case "rwan":
+		return true, RUAN
+	case "rwun":
+		return true, RUN
+	case "ji":
+		return true, JI
+	case "jya":
+		return true, JIA
+	case "jyau":
+		return true, JIAO
+	case "jye":
+		return true, JIE
+	case "jyou":
+		return true, JIU
+	case "jyan":
+		return true, JIAN
+	case "jin":
+		return true, JIN
+	case "jyang":
+		return true, JIANG
+	case "jing":
+		return true, JING
+	case "jyung":
+		return true, JIONG
+	case "jyu":
+		return true, JU
+	case "jywe":
+		return true, JUE
+	case "jywan":
+		return true, JUAN
+	case "jyun":
+		return true, JUN
+	case "chi":
+		return true, QI
+	case "chya":
+		return true, QIA
+	case "chyau":
+		return true, QIAO
+	case "chye":
+		return true, QIE
+	case "chyou":
+		return true, QIU
+	case "chyan":
+		return true, QIAN
+	case "chin":
+		return true, QIN
+	case "chyang":
+		return true, QIANG
+	case "ching":
+		return true, QING
+	case "chyung":
+		return true, QIONG
+	case "chyu":
+		return true, QU
+	case "chywe":
+		return true, QUE
+	case "chywan":
+		return true, QUAN
+	case "chyun":
+		return true, QUN
+	case "syi":
+		return true, XI
+	case "sya":
+		return true, XIA
+	case "syau":
+		return true, XIAO
+	case "sye":
+		return true, XIE
+	case "syou":
+		return true, XIU
+	case "syan":
+		return true, XIAN
+	case "syin":
+		return true, XIN
+	case "syang":
+		return true, XIANG
+	case "sying":
+		return true, XING
+	case "syung":
+		return true, XIONG
+	case "syu":
+		return true, XU
+	case "sywe":
+		return true, XUE
+	case "sywan":
+		return true, XUAN
+	case "syun":
+		return true, XUN
+	case "fyau":
+		return true, FIAO
+	case "n":
+		return true, N
+	case "ng":
+		return true, NG
+	case "m":
+		return true, M
+	case "yo":
+		return true, YO
+	case "hm":
+		return true, HM
+	case "lo":
+		return true, LO
+	case "ei":
+		return true, EI
+	case "nwun":
+		return true, NUN
+	case "tsei":
+		return true, CEI
+	case "din":
+		return true, DIN
+	case "byang":
+		return true, BIANG
+	default:
+		return false, 0
+	}
+}
+
+const maxYaleLength = 6
+
+func (p Sound) gr() string {
+	switch p {
+	case A:
+		return "a"
+	case O:
+		return "o"
+	case E:
+		return "e"
+	case ER:
+		return "el"
+	case AI:
+		return "ai"
+	case AO:
+		return "au"
+	case OU:
+		return "ou"
+	case AN:
+		return "an"
+	case EN:
+		return "en"
+	case ANG:
+		return "ang"
+	case ENG:
+		return "eng"
+	case YI:
+		return "i"
+	case YA:
+		return "ia"
+	case YAO:
+		return "iau"
+	case YE:
+		return "ie"
+	case YOU:
+		return "iou"
+	case YAN:
+		return "ian"
+	case YIN:
+		return "in"
+	case YANG:
+		return "iang"
+	case YING:
+		return "ing"
+	case YONG:
+		return "iong"
+	case WU:
+		return "u"
+	case WA:
+		return "ua"
+	case WO:
+		return "uo"
+	case WAI:
+		return "uai"
+	case WEI:
+		return "uei"
+	case WAN:
+		return "uan"
+	case WEN:
+		return "uen"
+	case WANG:
+		return "uang"
+	case WENG:
+		return "ueng"
+	case YU:
+		return "iu"
+	case YUE:
+		return "iue"
+	case YUAN:
+		return "iuan"
+	case YUN:
+		return "iun"
+	case BA:
+		return "ba"
+	case BO:
+		return "bo"
+	case BAI:
+		return "bai"
+	case BEI:
+		return "bei"
+	case BAO:
+		return "bau"
+	case BAN:
+		return "ban"
+	case BEN:
+		return "ben"
+	case BANG:
+		return "bang"
+	case BENG:
+		return "beng"
+	case BI:
+		return "bi"
+	case BIAO:
+		return "biau"
+	case BIE:
+		return "bie"
+	case BIAN:
+		return "bian"
+	case BIN:
+		return "bin"
+	case BING:
+		return "bing"
+	case BU:
+		return "bu"
+	case PA:
+		return "pa"
+	case PO:
+		return "po"
+	case PAI:
+		return "pai"
+	case PEI:
+		return "pei"
+	case PAO:
+		return "pau"
+	case POU:
+		return "pou"
+	case PAN:
+		return "pan"
+	case PEN:
+		return "pen"
+	case PANG:
+		return "pang"
+	case PENG:
+		return "peng"
+	case PI:
+		return "pi"
+	case PIAO:
+		return "piau"
+	case PIE:
+		return "pie"
+	case PIAN:
+		return "pian"
+	case PIN:
+		return "pin"
+	case PING:
+		return "ping"
+	case PU:
+		return "pu"
+	case MA:
+		return "ma"
+	case MO:
+		return "mo"
+	case ME:
+		return "me"
+	case MAI:
+		return "mai"
+	case MEI:
+		return "mei"
+	case MAO:
+		return "mau"
+	case MOU:
+		return "mou"
+	case MAN:
+		return "man"
+	case MEN:
+		return "men"
+	case MANG:
+		return "mang"
+	case MENG:
+		return "meng"
+	case MI:
+		return "mi"
+	case MIAO:
+		return "miau"
+	case MIE:
+		return "mie"
+	case MIU:
+		return "miou"
+	case MIAN:
+		return "mian"
+	case MIN:
+		return "min"
+	case MING:
+		return "ming"
+	case MU:
+		return "mu"
+	case FA:
+		return "fa"
+	case FO:
+		return "fo"
+	case FEI:
+		return "fei"
+	case FOU:
+		return "fou"
+	case FAN:
+		return "fan"
+	case FEN:
+		return "fen"
+	case FANG:
+		return "fang"
+	case FENG:
+		return "feng"
+	case FU:
+		return "fu"
+	case DA:
+		return "da"
+	case DE:
+		return "de"
+	case DAI:
+		return "dai"
+	case DEI:
+		return "dei"
+	case DAO:
+		return "dau"
+	case DOU:
+		return "dou"
+	case DAN:
+		return "dan"
+	case DEN:
+		return "den"
+	case DANG:
+		return "dang"
+	case DENG:
+		return "deng"
+	case DONG:
+		return "dong"
+	case DI:
+		return "di"
+	case DIAO:
+		return "diau"
+	case DIE:
+		return "die"
+	case DIU:
+		return "diou"
+	case DIAN:
+		return "dian"
+	case DING:
+		return "ding"
+	case DU:
+		return "du"
+	case DUO:
+		return "duo"
+	case DUI:
+		return "duei"
+	case DUAN:
+		return "duan"
+	case DUN:
+		return "duen"
+	case TA:
+		return "ta"
+	case TE:
+		return "te"
+	case TAI:
+		return "tai"
+	case TEI:
+		return "tei"
+	case TAO:
+		return "tau"
+	case TOU:
+		return "tou"
+	case TAN:
+		return "tan"
+	case TANG:
+		return "tang"
+	case TENG:
+		return "teng"
+	case TONG:
+		return "tong"
+	case TI:
+		return "ti"
+	case TIAO:
+		return "tiau"
+	case TIE:
+		return "tie"
+	case TIAN:
+		return "tian"
+	case TING:
+		return "ting"
+	case TU:
+		return "tu"
+	case TUO:
+		return "tuo"
+	case TUI:
+		return "tuei"
+	case TUAN:
+		return "tuan"
+	case TUN:
+		return "tuen"
+	case NA:
+		return "na"
+	case NE:
+		return "ne"
+	case NAI:
+		return "nai"
+	case NEI:
+		return "nei"
+	case NAO:
+		return "nau"
+	case NOU:
+		return "nou"
+	case NAN:
+		return "nan"
+	case NEN:
+		return "nen"
+	case NANG:
+		return "nang"
+	case NENG:
+		return "neng"
+	case NONG:
+		return "nong"
+	case NI:
+		return "ni"
+	case NIAO:
+		return "niau"
+	case NIE:
+		return "nie"
+	case NIU:
+		return "niou"
+	case NIAN:
+		return "nian"
+	case NIN:
+		return "nin"
+	case NIANG:
+		return "niang"
+	case NING:
+		return "ning"
+	case NU:
+		return "nu"
+	case NUO:
+		return "nuo"
+	case NUAN:
+		return "nuan"
+	case NÜ:
+		return "niu"
+	case NÜE:
+		return "niue"
+	case LA:
+		return "la"
+	case LE:
+		return "le"
+	case LAI:
+		return "lai"
+	case LEI:
+		return "lei"
+	case LAO:
+		return "lau"
+	case LOU:
+		return "lou"
+	case LAN:
+		return "lan"
+	case LANG:
+		return "lang"
+	case LENG:
+		return "leng"
+	case LONG:
+		return "long"
+	case LI:
+		return "li"
+	case LIA:
+		return "lia"
+	case LIAO:
+		return "liau"
+	case LIE:
+		return "lie"
+	case LIU:
+		return "liou"
+	case LIAN:
+		return "lian"
+	case LIN:
+		return "lin"
+	case LIANG:
+		return "liang"
+	case LING:
+		return "ling"
+	case LU:
+		return "lu"
+	case LUO:
+		return "luo"
+	case LUAN:
+		return "luan"
+	case LUN:
+		return "luen"
+	case LÜ:
+		return "liu"
+	case LÜE:
+		return "liue"
+	case GA:
+		return "ga"
+	case GE:
+		return "ge"
+	case GAI:
+		return "gai"
+	case GEI:
+		return "gei"
+	case GAO:
+		return "gau"
+	case GOU:
+		return "gou"
+	case GAN:
+		return "gan"
+	case GEN:
+		return "gen"
+	case GANG:
+		return "gang"
+	case GENG:
+		return "geng"
+	case GONG:
+		return "gong"
+	case GU:
+		return "gu"
+	case GUA:
+		return "gua"
+	case GUO:
+		return "guo"
+	case GUAI:
+		return "guai"
+	case GUI:
+		return "guei"
+	case GUAN:
+		return "guan"
+	case GUN:
+		return "guen"
+	case GUANG:
+		return "guang"
+	case KA:
+		return "ka"
+	case KE:
+		return "ke"
+	case KAI:
+		return "kai"
+	case KEI:
+		return "kei"
+	case KAO:
+		return "kau"
+	case KOU:
+		return "kou"
+	case KAN:
+		return "kan"
+	case KEN:
+		return "ken"
+	case KANG:
+		return "kang"
+	case KENG:
+		return "keng"
+	case KONG:
+		return "kong"
+	case KU:
+		return "ku"
+	case KUA:
+		return "kua"
+	case KUO:
+		return "kuo"
+	case KUAI:
+		return "kuai"
+	case KUI:
+		return "kuei"
+	case KUAN:
+		return "kuan"
+	case KUN:
+		return "kuen"
+	case KUANG:
+		return "kuang"
+	case HA:
+		return "ha"
+	case HE:
+		return "he"
+	case HAI:
+		return "hai"
+	case HEI:
+		return "hei"
+	case HAO:
+		return "hau"
+	case HOU:
+		return "hou"
+	case HAN:
+		return "han"
+	case HEN:
+		return "hen"
+	case HANG:
+		return "hang"
+	case HENG:
+		return "heng"
+	case HONG:
+		return "hong"
+	case HU:
+		return "hu"
+	case HUA:
+		return "hua"
+	case HUO:
+		return "huo"
+	case HUAI:
+		return "huai"
+	case HUI:
+		return "huei"
+	case HUAN:
+		return "huan"
+	case HUN:
+		return "huen"
+	case HUANG:
+		return "huang"
+	case ZA:
+		return "tza"
+	case ZE:
+		return "tze"
+	case ZI:
+		return "tzy"
+	case ZAI:
+		return "tzai"
+	case ZEI:
+		return "tzei"
+	case ZAO:
+		return "tzau"
+	case ZOU:
+		return "tzou"
+	case ZAN:
+		return "tzan"
+	case ZEN:
+		return "tzen"
+	case ZANG:
+		return "tzang"
+	case ZENG:
+		return "tzeng"
+	case ZONG:
+		return "tzong"
+	case ZU:
+		return "tzu"
+	case ZUO:
+		return "tzuo"
+	case ZUI:
+		return "tzuei"
+	case ZUAN:
+		return "tzuan"
+	case ZUN:
+		return "tzuen"
+	case CA:
+		return "tsa"
+	case CE:
+		return "tse"
+	case CI:
+		return "tsy"
+	case CAI:
+		return "tsai"
+	case CAO:
+		return "tsau"
+	case COU:
+		return "tsou"
+	case CAN:
+		return "tsan"
+	case CEN:
+		return "tsen"
+	case CANG:
+		return "tsang"
+	case CENG:
+		return "tseng"
+	case CONG:
+		return "tsong"
+	case CU:
+		return "tsu"
+	case CUO:
+		return "tsuo"
+	case CUI:
+		return "tsuei"
+	case CUAN:
+		return "tsuan"
+	case CUN:
+		return "tsuen"
+	case SA:
+		return "sa"
+	case SE:
+		return "se"
+	case SI:
+		return "sy"
+	case SAI:
+		return "sai"
+	case SAO:
+		return "sau"
+	case SOU:
+		return "sou"
+	case SAN:
+		return "san"
+	case SEN:
+		return "sen"
+	case SANG:
+		return "sang"
+	case SENG:
+		return "seng"
+	case SONG:
+		return "song"
+	case SU:
+		return "su"
+	case SUO:
+		return "suo"
+	case SUI:
+		return "suei"
+	case SUAN:
+		return "suan"
+	case SUN:
+		return "suen"
+	case ZHA:
+		return "ja"
+	case ZHE:
+		return "je"
+	case ZHI:
+		return "jy"
+	case ZHAI:
+		return "jai"
+	case ZHEI:
+		return "jei"
+	case ZHAO:
+		return "jau"
+	case ZHOU:
+		return "jou"
+	case ZHAN:
+		return "jan"
+	case ZHEN:
+		return "jen"
+	case ZHANG:
+		return "jang"
+	case ZHENG:
+		return "jeng"
+	case ZHONG:
+		return "jong"
+	case ZHU:
+		return "ju"
+	case ZHUA:
+		return "jua"
+	case ZHUO:
+		return "juo"
+	case ZHUAI:
+		return "juai"
+	case ZHUI:
+		return "juei"
+	case ZHUAN:
+		return "juan"
+	case ZHUN:
+		return "juen"
+	case ZHUANG:
+		return "juang"
+	case CHA:
+		return "cha"
+	case CHE:
+		return "che"
+	case CHI:
+		return "chy"
+	case CHAI:
+		return "chai"
+	case CHAO:
+		return "chau"
+	case CHOU:
+		return "chou"
+	case CHAN:
+		return "chan"
+	case CHEN:
+		return "chen"
+	case CHANG:
+		return "chang"
+	case CHENG:
+		return "cheng"
+	case CHONG:
+		return "chong"
+	case CHU:
+		return "chu"
+	case CHUA:
+		return "chua"
+	case CHUO:
+		return "chuo"
+	case CHUAI:
+		return "chuai"
+	case CHUI:
+		return "chuei"
+	case CHUAN:
+		return "chuan"
+	case CHUN:
+		return "chuen"
+	case CHUANG:
+		return "chuang"
+	case SHA:
+		return "sha"
+	case SHE:
+		return "she"
+	case SHI:
+		return "shy"
+	case SHAI:
+		return "shai"
+	case SHEI:
+		return "shei"
+	case SHAO:
+		return "shau"
+	case SHOU:
+		return "shou"
+	case SHAN:
+		return "shan"
+	case SHEN:
+		return "shen"
+	case SHANG:
+		return "shang"
+	case SHENG:
+		return "sheng"
+	case SHU:
+		return "shu"
+	case SHUA:
+		return "shua"
+	case SHUO:
+		return "shuo"
+	case SHUAI:
+		return "shuai"
+	case SHUI:
+		return "shuei"
+	case SHUAN:
+		return "shuan"
+	case SHUN:
+		return "shuen"
+	case SHUANG:
+		return "shuang"
+	case RE:
+		return "re"
+	case RI:
+		return "ry"
+	case RAO:
+		return "rau"
+	case ROU:
+		return "rou"
+	case RAN:
+		return "ran"
+	case REN:
+		return "ren"
+	case RANG:
+		return "rang"
+	case RENG:
+		return "reng"
+	case RONG:
+		return "rong"
+	case RU:
+		return "ru"
+	case RUA:
+		return "rua"
+	case RUO:
+		return "ruo"
+	case RUI:
+		return "ruei"
+	case RUAN:
+		return "ruan"
+	case RUN:
+		return "ruen"
+	case JI:
+		return "ji"
+	case JIA:
+		return "jia"
+	case JIAO:
+		return "jiau"
+	case JIE:
+		return "jie"
+	case JIU:
+		return "jiou"
+	case JIAN:
+		return "jian"
+	case JIN:
+		return "jin"
+	case JIANG:
+		return "jiang"
+	case JING:
+		return "jing"
+	case JIONG:
+		return "jiong"
+	case JU:
+		return "jiu"
+	case JUE:
+		return "jiue"
+	case JUAN:
+		return "jiuan"
+	case JUN:
+		return "jiun"
+	case QI:
+		return "chi"
+	case QIA:
+		return "chia"
+	case QIAO:
+		return "chiau"
+	case QIE:
+		return "chie"
+	case QIU:
+		return "chiou"
+	case QIAN:
+		return "chian"
+	case QIN:
+		return "chin"
+	case QIANG:
+		return "chiang"
+	case QING:
+		return "ching"
+	case QIONG:
+		return "chiong"
+	case QU:
+		return "chiu"
+	case QUE:
+		return "chiue"
+	case QUAN:
+		return "chiuan"
+	case QUN:
+		return "chiun"
+	case XI:
+		return "shi"
+	case XIA:
+		return "shia"
+	case XIAO:
+		return "shiau"
+	case XIE:
+		return "shie"
+	case XIU:
+		return "shiou"
+	case XIAN:
+		return "shian"
+	case XIN:
+		return "shin"
+	case XIANG:
+		return "shiang"
+	case XING:
+		return "shing"
+	case XIONG:
+		return "shiong"
+	case XU:
+		return "shiu"
+	case XUE:
+		return "shiue"
+	case XUAN:
+		return "shiuan"
+	case XUN:
+		return "shiun"
+	case FIAO:
+		return "fiau"
+	case N:
+		return "n"
+	case NG:
+		return "ng"
+	case M:
+		return "m"
+	case YO:
+		return "io"
+	case HM:
+		return "hm"
+	case LO:
+		return "lo"
+	case EI:
+		return "ei"
+	case NUN:
+		return "nuen"
+	case CEI:
+		return "tsei"
+	case WONG:
+		return "ueng"
+	case DIN:
+		return "din"
+	case BIANG:
+		return "biang"
+	case R:
+		return "l"
+	default:
+		return "?"
+	}
+}
+
+func grSound(str string) (bool, Sound) {
+	switch str {
+	case "a":
+		return true, A
+	case "o":
+		return true, O
+	case "e":
+		return true, E
+	case "el":
+		return true, ER
+	case "ai":
+		return true, AI
+	case "au":
+		return true, AO
+	case "ou":
+		return true, OU
+	case "an":
+		return true, AN
+	case "en":
+		return true, EN
+	case "ang":
+		return true, ANG
+	case "eng":
+		return true, ENG
+	case "i":
+		return true, YI
+	case "ia":
+		return true, YA
+	case "iau":
+		return true, YAO
+	case "ie":
+		return true, YE
+	case "iou":
+		return true, YOU
+	case "ian":
+		return true, YAN
+	case "in":
+		return true, YIN
+	case "iang":
+		return true, YANG
+	case "ing":
+		return true, YING
+	case "iong":
+		return true, YONG
+	case "u":
+		return true, WU
+	case "ua":
+		return true, WA
+	case "uo":
+		return true, WO
+	case "uai":
+		return true, WAI
+	case "uei":
+		return true, WEI
+	case "uan":
+		return true, WAN
+	case "uen":
+		return true, WEN
+	case "uang":
+		return true, WANG
+	case "ueng":
+		return true, WENG
+	case "iu":
+		return true, YU
+	case "iue":
+		return true, YUE
+	case "iuan":
+		return true, YUAN
+	case "iun":
+		return true, YUN
+	case "ba":
+		return true, BA
+	case "bo":
+		return true, BO
+	case "bai":
+		return true, BAI
+	case "bei":
+		return true, BEI
+	case "bau":
+		return true, BAO
+	case "ban":
+		return true, BAN
+	case "ben":
+		return true, BEN
+	case "bang":
+		return true, BANG
+	case "beng":
+		return true, BENG
+	case "bi":
+		return true, BI
+	case "biau":
+		return true, BIAO
+	case "bie":
+		return true, BIE
+	case "bian":
+		return true, BIAN
+	case "bin":
+		return true, BIN
+	case "bing":
+		return true, BING
+	case "bu":
+		return true, BU
+	case "pa":
+		return true, PA
+	case "po":
+		return true, PO
+	case "pai":
+		return true, PAI
+	case "pei":
+		return true, PEI
+	case "pau":
+		return true, PAO
+	case "pou":
+		return true, POU
+	case "pan":
+		return true, PAN
+	case "pen":
+		return true, PEN
+	case "pang":
+		return true, PANG
+	case "peng":
+		return true, PENG
+	case "pi":
+		return true, PI
+	case "piau":
+		return true, PIAO
+	case "pie":
+		return true, PIE
+	case "pian":
+		return true, PIAN
+	case "pin":
+		return true, PIN
+	case "ping":
+		return true, PING
+	case "pu":
+		return true, PU
+	case "ma":
+		return true, MA
+	case "mo":
+		return true, MO
+	case "me":
+		return true, ME
+	case "mai":
+		return true, MAI
+	case "mei":
+		return true, MEI
+	case "mau":
+		return true, MAO
+	case "mou":
+		return true, MOU
+	case "man":
+		return true, MAN
+	case "men":
+		return true, MEN
+	case "mang":
+		return true, MANG
+	case "meng":
+		return true, MENG
+	case "mi":
+		return true, MI
+	case "miau":
+		return true, MIAO
+	case "mie":
+		return true, MIE
+	case "miou":
+		return true, MIU
+	case "mian":
+		return true, MIAN
+	case "min":
+		return true, MIN
+	case "ming":
+		return true, MING
+	case "mu":
+		return true, MU
+	case "fa":
+		return true, FA
+	case "fo":
+		return true, FO
+	case "fei":
+		return true, FEI
+	case "fou":
+		return true, FOU
+	case "fan":
+		return true, FAN
+	case "fen":
+		return true, FEN
+	case "fang":
+		return true, FANG
+	case "feng":
+		return true, FENG
+	case "fu":
+		return true, FU
+	case "da":
+		return true, DA
+	case "de":
+		return true, DE
+	case "dai":
+		return true, DAI
+	case "dei":
+		return true, DEI
+	case "dau":
+		return true, DAO
+	case "dou":
+		return true, DOU
+	case "dan":
+		return true, DAN
+	case "den":
+		return true, DEN
+	case "dang":
+		return true, DANG
+	case "deng":
+		return true, DENG
+	case "dong":
+		return true, DONG
+	case "di":
+		return true, DI
+	case "diau":
+		return true, DIAO
+	case "die":
+		return true, DIE
+	case "diou":
+		return true, DIU
+	case "dian":
+		return true, DIAN
+	case "ding":
+		return true, DING
+	case "du":
+		return true, DU
+	case "duo":
+		return true, DUO
+	case "duei":
+		return true, DUI
+	case "duan":
+		return true, DUAN
+	case "duen":
+		return true, DUN
+	case "ta":
+		return true, TA
+	case "te":
+		return true, TE
+	case "tai":
+		return true, TAI
+	case "tei":
+		return true, TEI
+	case "tau":
+		return true, TAO
+	case "tou":
+		return true, TOU
+	case "tan":
+		return true, TAN
+	case "tang":
+		return true, TANG
+	case "teng":
+		return true, TENG
+	case "tong":
+		return true, TONG
+	case "ti":
+		return true, TI
+	case "tiau":
+		return true, TIAO
+	case "tie":
+		return true, TIE
+	case "tian":
+		return true, TIAN
+	case "ting":
+		return true, TING
+	case "tu":
+		return true, TU
+	case "tuo":
+		return true, TUO
+	case "tuei":
+		return true, TUI
+	case "tuan":
+		return true, TUAN
+	case "tuen":
+		return true, TUN
+	case "na":
+		return true, NA
+	case "ne":
+		return true, NE
+	case "nai":
+		return true, NAI
+	case "nei":
+		return true, NEI
+	case "nau":
+		return true, NAO
+	case "nou":
+		return true, NOU
+	case "nan":
+		return true, NAN
+	case "nen":
+		return true, NEN
+	case "nang":
+		return true, NANG
+	case "neng":
+		return true, NENG
+	case "nong":
+		return true, NONG
+	case "ni":
+		return true, NI
+	case "niau":
+		return true, NIAO
+	case "nie":
+		return true, NIE
+	case "niou":
+		return true, NIU
+	case "nian":
+		return true, NIAN
+	case "nin":
+		return true, NIN
+	case "niang":
+		return true, NIANG
+	case "ning":
+		return true, NING
+	case "nu":
+		return true, NU
+	case "nuo":
+		return true, NUO
+	case "nuan":
+		return true, NUAN
+	case "niu":
+		return true, NÜ
+	case "niue":
+		return true, NÜE
+	case "la":
+		return true, LA
+	case "le":
+		return true, LE
+	case "lai":
+		return true, LAI
+	case "lei":
+		return true, LEI
+	case "lau":
+		return true, LAO
+	case "lou":
+		return true, LOU
+	case "lan":
+		return true, LAN
+	case "lang":
+		return true, LANG
+	case "leng":
+		return true, LENG
+	case "long":
+		return true, LONG
+	case "li":
+		return true, LI
+	case "lia":
+		return true, LIA
+	case "liau":
+		return true, LIAO
+	case "lie":
+		return true, LIE
+	case "liou":
+		return true, LIU
+	case "lian":
+		return true, LIAN
+	case "lin":
+		return true, LIN
+	case "liang":
+		return true, LIANG
+	case "ling":
+		return true, LING
+	case "lu":
+		return true, LU
+	case "luo":
+		return true, LUO
+	case "luan":
+		return true, LUAN
+	case "luen":
+		return true, LUN
+	case "liu":
+		return true, LÜ
+	case "liue":
+		return true, LÜE
+	case "ga":
+		return true, GA
+	case "ge":
+		return true, GE
+	case "gai":
+		return true, GAI
+	case "gei":
+		return true, GEI
+	case "gau":
+		return true, GAO
+	case "gou":
+		return true, GOU
+	case "gan":
+		return true, GAN
+	case "gen":
+		return true, GEN
+	case "gang":
+		return true, GANG
+	case "geng":
+		return true, GENG
+	case "gong":
+		return true, GONG
+	case "gu":
+		return true, GU
+	case "gua":
+		return true, GUA
+	case "guo":
+		return true, GUO
+	case "guai":
+		return true, GUAI
+	case "guei":
+		return true, GUI
+	case "guan":
+		return true, GUAN
+	case "guen":
+		return true, GUN
+	case "guang":
+		return true, GUANG
+	case "ka":
+		return true, KA
+	case "ke":
+		return true, KE
+	case "kai":
+		return true, KAI
+	case "kei":
+		return true, KEI
+	case "kau":
+		return true, KAO
+	case "kou":
+		return true, KOU
+	case "kan":
+		return true, KAN
+	case "ken":
+		return true, KEN
+	case "kang":
+		return true, KANG
+	case "keng":
+		return true, KENG
+	case "kong":
+		return true, KONG
+	case "ku":
+		return true, KU
+	case "kua":
+		return true, KUA
+	case "kuo":
+		return true, KUO
+	case "kuai":
+		return true, KUAI
+	case "kuei":
+		return true, KUI
+	case "kuan":
+		return true, KUAN
+	case "kuen":
+		return true, KUN
+	case "kuang":
+		return true, KUANG
+	case "ha":
+		return true, HA
+	case "he":
+		return true, HE
+	case "hai":
+		return true, HAI
+	case "hei":
+		return true, HEI
+	case "hau":
+		return true, HAO
+	case "hou":
+		return true, HOU
+	case "han":
+		return true, HAN
+	case "hen":
+		return true, HEN
+	case "hang":
+		return true, HANG
+	case "heng":
+		return true, HENG
+	case "hong":
+		return true, HONG
+	case "hu":
+		return true, HU
+	case "hua":
+		return true, HUA
+	case "huo":
+		return true, HUO
+	case "huai":
+		return true, HUAI
+	case "huei":
+		return true, HUI
+	case "huan":
+		return true, HUAN
+	case "huen":
+		return true, HUN
+	case "huang":
+		return true, HUANG
+	case "tza":
+		return true, ZA
+	case "tze":
+		return true, ZE
+	case "tzy":
+		return true, ZI
+	case "tzai":
+		return true, ZAI
+	case "tzei":
+		return true, ZEI
+	case "tzau":
+		return true, ZAO
+	case "tzou":
+		return true, ZOU
+	case "tzan":
+		return true, ZAN
+	case "tzen":
+		return true, ZEN
+	case "tzang":
+		return true, ZANG
+	case "tzeng":
+		return true, ZENG
+	case "tzong":
+		return true, ZONG
+	case "tzu":
+		return true, ZU
+	case "tzuo":
+		return true, ZUO
+	case "tzuei":
+		return true, ZUI
+	case "tzuan":
+		return true, ZUAN
+	case "tzuen":
+		return true, ZUN
+	case "tsa":
+		return true, CA
+	case "tse":
+		return true, CE
+	case "tsy":
+		return true, CI
+	case "tsai":
+		return true, CAI
+	case "tsau":
+		return true, CAO
+	case "tsou":
+		return true, COU
+	case "tsan":
+		return true, CAN
+	case "tsen":
+		return true, CEN
+	case "tsang":
+		return true, CANG
+	case "tseng":
+		return true, CENG
+	case "tsong":
+		return true, CONG
+	case "tsu":
+		return true, CU
+	case "tsuo":
+		return true, CUO
+	case "tsuei":
+		return true, CUI
+	case "tsuan":
+		return true, CUAN
+	case "tsuen":
+		return true, CUN
+	case "sa":
+		return true, SA
+	case "se":
+		return true, SE
+	case "sy":
+		return true, SI
+	case "sai":
+		return true, SAI
+	case "sau":
+		return true, SAO
+	case "sou":
+		return true, SOU
+	case "san":
+		return true, SAN
+	case "sen":
+		return true, SEN
+	case "sang":
+		return true, SANG
+	case "seng":
+		return true, SENG
+	case "song":
+		return true, SONG
+	case "su":
+		return true, SU
+	case "suo":
+		return true, SUO
+	case "suei":
+		return true, SUI
+	case "suan":
+		return true, SUAN
+	case "suen":
+		return true, SUN
+	case "ja":
+		return true, ZHA
+	case "je":
+		return true, ZHE
+	case "jy":
+		return true, ZHI
+	case "jai":
+		return true, ZHAI
+	case "jei":
+		return true, ZHEI
+	case "jau":
+		return true, ZHAO
+	case "jou":
+		return true, ZHOU
+	case "jan":
+		return true, ZHAN
+	case "jen":
+		return true, ZHEN
+	case "jang":
+		return true, ZHANG
+	case "jeng":
+		return true, ZHENG
+	case "jong":
+		return true, ZHONG
+	case "ju":
+		return true, ZHU
+	case "jua":
+		return true, ZHUA
+	case "juo":
+		return true, ZHUO
+	case "juai":
+		return true, ZHUAI
+	case "juei":
+		return true, ZHUI
+	case "juan":
+		return true, ZHUAN
+	case "juen":
+		return true, ZHUN
+	case "juang":
+		return true, ZHUANG
+	case "cha":
+		return true, CHA
+	case "che":
+		return true, CHE
+	case "chy":
+		return true, CHI
+	case "chai":
+		return true, CHAI
+	case "chau":
+		return true, CHAO
+	case "chou":
+		return true, CHOU
+	case "chan":
+		return true, CHAN
+	case "chen":
+		return true, CHEN
+	case "chang":
+		return true, CHANG
+	case "cheng":
+		return true, CHENG
+	case "chong":
+		return true, CHONG
+	case "chu":
+		return true, CHU
+	case "chua":
+		return true, CHUA
+	case "chuo":
+		return true, CHUO
+	case "chuai":
+		return true, CHUAI
+	case "chuei":
+		return true, CHUI
+	case "chuan":
+		return true, CHUAN
+	case "chuen":
+		return true, CHUN
+	case "chuang":
+		return true, CHUANG
+	case "sha":
+		return true, SHA
+	case "she":
+		return true, SHE
+	case "shy":
+		return true, SHI
+	case "shai":
+		return true, SHAI
+	case "shei":
+		return true, SHEI
+	case "shau":
+		return true, SHAO
+	case "shou":
+		return true, SHOU
+	case "shan":
+		return true, SHAN
+	case "shen":
+		return true, SHEN
+	case "shang":
+		return true, SHANG
+	case "sheng":
+		return true, SHENG
+	case "shu":
+		return true, SHU
+	case "shua":
+		return true, SHUA
+	case "shuo":
+		return true, SHUO
+	case "shuai":
+		return true, SHUAI
+	case "shuei":
+		return true, SHUI
+	case "shuan":
+		return true, SHUAN
+	case "shuen":
+		return true, SHUN
+	case "shuang":
+		return true, SHUANG
+	case "re":
+		return true, RE
+	case "ry":
+		return true, RI
+	case "rau":
+		return true, RAO
+	case "rou":
+		return true, ROU
+	case "ran":
+		return true, RAN
+	case "ren":
+		return true, REN
+	case "rang":
+		return true, RANG
+	case "reng":
+		return true, RENG
+	case "rong":
+		return true, RONG
+	case "ru":
+		return true, RU
+	case "rua":
+		return true, RUA
+	case "ruo":
+		return true, RUO
+	case "ruei":
+		return true, RUI
+	case "ruan":
+		return true, RUAN
+	case "ruen":
+		return true, RUN
+	case "ji":
+		return true, JI
+	case "jia":
+		return true, JIA
+	case "jiau":
+		return true, JIAO
+	case "jie":
+		return true, JIE
+	case "jiou":
+		return true, JIU
+	case "jian":
+		return true, JIAN
+	case "jin":
+		return true, JIN
+	case "jiang":
+		return true, JIANG
+	case "jing":
+		return true, JING
+	case "jiong":
+		return true, JIONG
+	case "jiu":
+		return true, JU
+	case "jiue":
+		return true, JUE
+	case "jiuan":
+		return true, JUAN
+	case "jiun":
+		return true, JUN
+	case "chi":
+		return true, QI
+	case "chia":
+		return true, QIA
+	case "chiau":
+		return true, QIAO
+	case "chie":
+		return true, QIE
+	case "chiou":
+		return true, QIU
+	case "chian":
+		return true, QIAN
+	case "chin":
+		return true, QIN
+	case "chiang":
+		return true, QIANG
+	case "ching":
+		return true, QING
+	case "chiong":
+		return true, QIONG
+	case "chiu":
+		return true, QU
+	case "chiue":
+		return true, QUE
+	case "chiuan":
+		return true, QUAN
+	case "chiun":
+		return true, QUN
+	case "shi":
+		return true, XI
+	case "shia":
+		return true, XIA
+	case "shiau":
+		return true, XIAO
+	case "shie":
+		return true, XIE
+	case "shiou":
+		return true, XIU
+	case "shian":
+		return true, XIAN
+	case "shin":
+		return true, XIN
+	case "shiang":
+		return true, XIANG
+	case "shing":
+		return true, XING
+	case "shiong":
+		return true, XIONG
+	case "shiu":
+		return true, XU
+	case "shiue":
+		return true, XUE
+	case "shiuan":
+		return true, XUAN
+	case "shiun":
+		return true, XUN
+	case "fiau":
+		return true, FIAO
+	case "n":
+		return true, N
+	case "ng":
+		return true, NG
+	case "m":
+		return true, M
+	case "io":
+		return true, YO
+	case "hm":
+		return true, HM
+	case "lo":
+		return true, LO
+	case "ei":
+		return true, EI
+	case "nuen":
+		return true, NUN
+	case "tsei":
+		return true, CEI
+	case "din":
+		return true, DIN
+	case "biang":
+		return true, BIANG
+	case "l":
+		return true, R
+	default:
+		return false, 0
+	}
+}
+
+const maxGrLength = 6
+
+func (p Sound) ipa() string {
+	switch p {
+	case A:
+		return "a"
+	case O:
+		return "o"
+	case E:
+		return "ɤ"
+	case ER:
+		return "ɚ"
+	case AI:
+		return "aɪ"
+	case AO:
+		return "ɑʊ"
+	case OU:
+		return "oʊ"
+	case AN:
+		return "an"
+	case EN:
+		return "ən"
+	case ANG:
+		return "ɑŋ"
+	case ENG:
+		return "əŋ"
+	case YI:
+		return "i"
+	case YA:
+		return "ja"
+	case YAO:
+		return "jɑʊ"
+	case YE:
+		return "jɛ"
+	case YOU:
+		return "joʊ"
+	case YAN:
+		return "jɛn"
+	case YIN:
+		return "in"
+	case YANG:
+		return "jɑŋ"
+	case YING:
+		return "iŋ"
+	case YONG:
+		return "jʊŋ"
+	case WU:
+		return "u"
+	case WA:
+		return "wa"
+	case WO:
+		return "wo"
+	case WAI:
+		return "waɪ"
+	case WEI:
+		return "weɪ"
+	case WAN:
+		return "wan"
+	case WEN:
+		return "wən"
+	case WANG:
+		return "wɑŋ"
+	case WENG:
+		return "wəŋ"
+	case YU:
+		return "y"
+	case YUE:
+		return "ɥɛ"
+	case YUAN:
+		return "ɥɛn"
+	case YUN:
+		return "yn"
+	case BA:
+		return "pa"
+	case BO:
+		return "pwo"
+	case BAI:
+		return "paɪ"
+	case BEI:
+		return "peɪ"
+	case BAO:
+		return "pɑʊ"
+	case BAN:
+		return "pan"
+	case BEN:
+		return "pən"
+	case BANG:
+		return "pɑŋ"
+	case BENG:
+		return "pəŋ"
+	case BI:
+		return "pi"
+	case BIAO:
+		return "pjɑʊ"
+	case BIE:
+		return "pjɛ"
+	case BIAN:
+		return "pjɛn"
+	case BIN:
+		return "pin"
+	case BING:
+		return "piŋ"
+	case BU:
+		return "pu"
+	case PA:
+		return "pʰa"
+	case PO:
+		return "pʰwo"
+	case PAI:
+		return "pʰaɪ"
+	case PEI:
+		return "pʰeɪ"
+	case PAO:
+		return "pʰɑʊ"
+	case POU:
+		return "pʰoʊ"
+	case PAN:
+		return "pʰan"
+	case PEN:
+		return "pʰən"
+	case PANG:
+		return "pʰɑŋ"
+	case PENG:
+		return "pʰəŋ"
+	case PI:
+		return "pʰi"
+	case PIAO:
+		return "pʰjɑʊ"
+	case PIE:
+		return "pʰjɛ"
+	case PIAN:
+		return "pʰjɛn"
+	case PIN:
+		return "pʰin"
+	case PING:
+		return "pʰiŋ"
+	case PU:
+		return "pʰu"
+	case MA:
+		return "ma"
+	case MO:
+		return "mwo"
+	case ME:
+		return "mɤ"
+	case MAI:
+		return "maɪ"
+	case MEI:
+		return "meɪ"
+	case MAO:
+		return "mɑʊ"
+	case MOU:
+		return "moʊ"
+	case MAN:
+		return "man"
+	case MEN:
+		return "mən"
+	case MANG:
+		return "mɑŋ"
+	case MENG:
+		return "məŋ"
+	case MI:
+		return "mi"
+	case MIAO:
+		return "mjɑʊ"
+	case MIE:
+		return "mjɛ"
+	case MIU:
+		return "mjoʊ"
+	case MIAN:
+		return "mjɛn"
+	case MIN:
+		return "min"
+	case MING:
+		return "miŋ"
+	case MU:
+		return "mu"
+	case FA:
+		return "fa"
+	case FO:
+		return "fwo"
+	case FEI:
+		return "feɪ"
+	case FOU:
+		return "foʊ"
+	case FAN:
+		return "fan"
+	case FEN:
+		return "fən"
+	case FANG:
+		return "fɑŋ"
+	case FENG:
+		return "fəŋ"
+	case FU:
+		return "fu"
+	case DA:
+		return "ta"
+	case DE:
+		return "tɤ"
+	case DAI:
+		return "taɪ"
+	case DEI:
+		return "teɪ"
+	case DAO:
+		return "tɑʊ"
+	case DOU:
+		return "toʊ"
+	case DAN:
+		return "tan"
+	case DEN:
+		return "tən"
+	case DANG:
+		return "tɑŋ"
+	case DENG:
+		return "təŋ"
+	case DONG:
+		return "tʊŋ"
+	case DI:
+		return "ti"
+	case DIAO:
+		return "tjɑʊ"
+	case DIE:
+		return "tjɛ"
+	case DIU:
+		return "tjoʊ"
+	case DIAN:
+		return "tjɛn"
+	case DING:
+		return "tiŋ"
+	case DU:
+		return "tu"
+	case DUO:
+		return "two"
+	case DUI:
+		return "tweɪ"
+	case DUAN:
+		return "twan"
+	case DUN:
+		return "twən"
+	case TA:
+		return "tʰa"
+	case TE:
+		return "tʰɤ"
+	case TAI:
+		return "tʰaɪ"
+	case TEI:
+		return "tʰeɪ"
+	case TAO:
+		return "tʰɑʊ"
+	case TOU:
+		return "tʰoʊ"
+	case TAN:
+		return "tʰan"
+	case TANG:
+		return "tʰɑŋ"
+	case TENG:
+		return "tʰəŋ"
+	case TONG:
+		return "tʰʊŋ"
+	case TI:
+		return "tʰi"
+	case TIAO:
+		return "tʰjɑʊ"
+	case TIE:
+		return "tʰjɛ"
+	case TIAN:
+		return "tʰjɛn"
+	case TING:
+		return "tʰiŋ"
+	case TU:
+		return "tʰu"
+	case TUO:
+		return "tʰwo"
+	case TUI:
+		return "tʰweɪ"
+	case TUAN:
+		return "tʰwan"
+	case TUN:
+		return "tʰwən"
+	case NA:
+		return "na"
+	case NE:
+		return "nɤ"
+	case NAI:
+		return "naɪ"
+	case NEI:
+		return "neɪ"
+	case NAO:
+		return "nɑʊ"
+	case NOU:
+		return "noʊ"
+	case NAN:
+		return "nan"
+	case NEN:
+		return "nən"
+	case NANG:
+		return "nɑŋ"
+	case NENG:
+		return "nəŋ"
+	case NONG:
+		return "nʊŋ"
+	case NI:
+		return "ni"
+	case NIAO:
+		return "njɑʊ"
+	case NIE:
+		return "njɛ"
+	case NIU:
+		return "njoʊ"
+	case NIAN:
+		return "njɛn"
+	case NIN:
+		return "nin"
+	case NIANG:
+		return "njɑŋ"
+	case NING:
+		return "niŋ"
+	case NU:
+		return "nu"
+	case NUO:
+		return "nwo"
+	case NUAN:
+		return "nwan"
+	case NÜ:
+		return "ny"
+	case NÜE:
+		return "nɥɛ"
+	case LA:
+		return "la"
+	case LE:
+		return "lɤ"
+	case LAI:
+		return "laɪ"
+	case LEI:
+		return "leɪ"
+	case LAO:
+		return "lɑʊ"
+	case LOU:
+		return "loʊ"
+	case LAN:
+		return "lan"
+	case LANG:
+		return "lɑŋ"
+	case LENG:
+		return "ləŋ"
+	case LONG:
+		return "lʊŋ"
+	case LI:
+		return "li"
+	case LIA:
+		return "lja"
+	case LIAO:
+		return "ljɑʊ"
+	case LIE:
+		return "ljɛ"
+	case LIU:
+		return "ljoʊ"
+	case LIAN:
+		return "ljɛn"
+	case LIN:
+		return "lin"
+	case LIANG:
+		return "ljɑŋ"
+	case LING:
+		return "liŋ"
+	case LU:
+		return "lu"
+	case LUO:
+		return "lwo"
+	case LUAN:
+		return "lwan"
+	case LUN:
+		return "lwən"
+	case LÜ:
+		return "ly"
+	case LÜE:
+		return "lɥɛ"
+	case GA:
+		return "ka"
+	case GE:
+		return "kɤ"
+	case GAI:
+		return "kaɪ"
+	case GEI:
+		return "keɪ"
+	case GAO:
+		return "kɑʊ"
+	case GOU:
+		return "koʊ"
+	case GAN:
+		return "kan"
+	case GEN:
+		return "kən"
+	case GANG:
+		return "kɑŋ"
+	case GENG:
+		return "kəŋ"
+	case GONG:
+		return "kʊŋ"
+	case GU:
+		return "ku"
+	case GUA:
+		return "kwa"
+	case GUO:
+		return "kwo"
+	case GUAI:
+		return "kwaɪ"
+	case GUI:
+		return "kweɪ"
+	case GUAN:
+		return "kwan"
+	case GUN:
+		return "kwən"
+	case GUANG:
+		return "kwɑŋ"
+	case KA:
+		return "kʰa"
+	case KE:
+		return "kʰɤ"
+	case KAI:
+		return "kʰaɪ"
+	case KEI:
+		return "kʰeɪ"
+	case KAO:
+		return "kʰɑʊ"
+	case KOU:
+		return "kʰoʊ"
+	case KAN:
+		return "kʰan"
+	case KEN:
+		return "kʰən"
+	case KANG:
+		return "kʰɑŋ"
+	case KENG:
+		return "kʰəŋ"
+	case KONG:
+		return "kʰʊŋ"
+	case KU:
+		return "kʰu"
+	case KUA:
+		return "kʰwa"
+	case KUO:
+		return "kʰwo"
+	case KUAI:
+		return "kʰwaɪ"
+	case KUI:
+		return "kʰweɪ"
+	case KUAN:
+		return "kʰwan"
+	case KUN:
+		return "kʰwən"
+	case KUANG:
+		return "kʰwɑŋ"
+	case HA:
+		return "xa"
+	case HE:
+		return "xɤ"
+	case HAI:
+		return "xaɪ"
+	case HEI:
+		return "xeɪ"
+	case HAO:
+		return "xɑʊ"
+	case HOU:
+		return "xoʊ"
+	case HAN:
+		return "xan"
+	case HEN:
+		return "xən"
+	case HANG:
+		return "xɑŋ"
+	case HENG:
+		return "xəŋ"
+	case HONG:
+		return "xʊŋ"
+	case HU:
+		return "xu"
+	case HUA:
+		return "xwa"
+	case HUO:
+		return "xwo"
+	case HUAI:
+		return "xwaɪ"
+	case HUI:
+		return "xweɪ"
+	case HUAN:
+		return "xwan"
+	case HUN:
+		return "xwən"
+	case HUANG:
+		return "xwɑŋ"
+	case ZA:
+		return "tsa"
+	case ZE:
+		return "tsɤ"
+	case ZI:
+		return "tsɹ̩"
+	case ZAI:
+		return "tsaɪ"
+	case ZEI:
+		return "tseɪ"
+	case ZAO:
+		return "tsɑʊ"
+	case ZOU:
+		return "tsoʊ"
+	case ZAN:
+		return "tsan"
+	case ZEN:
+		return "tsən"
+	case ZANG:
+		return "tsɑŋ"
+	case ZENG:
+		return "tsəŋ"
+	case ZONG:
+		return "tsʊŋ"
+	case ZU:
+		return "tsu"
+	case ZUO:
+		return "tswo"
+	case ZUI:
+		return "tsweɪ"
+	case ZUAN:
+		return "tswan"
+	case ZUN:
+		return "tswən"
+	case CA:
+		return "tsʰa"
+	case CE:
+		return "tsʰɤ"
+	case CI:
+		return "tsʰɹ̩"
+	case CAI:
+		return "tsʰaɪ"
+	case CAO:
+		return "tsʰɑʊ"
+	case COU:
+		return "tsʰoʊ"
+	case CAN:
+		return "tsʰan"
+	case CEN:
+		return "tsʰən"
+	case CANG:
+		return "tsʰɑŋ"
+	case CENG:
+		return "tsʰəŋ"
+	case CONG:
+		return "tsʰʊŋ"
+	case CU:
+		return "tsʰu"
+	case CUO:
+		return "tsʰwo"
+	case CUI:
+		return "tsʰweɪ"
+	case CUAN:
+		return "tsʰwan"
+	case CUN:
+		return "tsʰwən"
+	case SA:
+		return "sa"
+	case SE:
+		return "sɤ"
+	case SI:
+		return "sɹ̩"
+	case SAI:
+		return "saɪ"
+	case SAO:
+		return "sɑʊ"
+	case SOU:
+		return "soʊ"
+	case SAN:
+		return "san"
+	case SEN:
+		return "sən"
+	case SANG:
+		return "sɑŋ"
+	case SENG:
+		return "səŋ"
+	case SONG:
+		return "sʊŋ"
+	case SU:
+		return "su"
+	case SUO:
+		return "swo"
+	case SUI:
+		return "sweɪ"
+	case SUAN:
+		return "swan"
+	case SUN:
+		return "swən"
+	case ZHA:
+		return "ʈʂa"
+	case ZHE:
+		return "ʈʂɤ"
+	case ZHI:
+		return "ʈʂɻ̩"
+	case ZHAI:
+		return "ʈʂaɪ"
+	case ZHEI:
+		return "ʈʂeɪ"
+	case ZHAO:
+		return "ʈʂɑʊ"
+	case ZHOU:
+		return "ʈʂoʊ"
+	case ZHAN:
+		return "ʈʂan"
+	case ZHEN:
+		return "ʈʂən"
+	case ZHANG:
+		return "ʈʂɑŋ"
+	case ZHENG:
+		return "ʈʂəŋ"
+	case ZHONG:
+		return "ʈʂʊŋ"
+	case ZHU:
+		return "ʈʂu"
+	case ZHUA:
+		return "ʈʂwa"
+	case ZHUO:
+		return "ʈʂwo"
+	case ZHUAI:
+		return "ʈʂwaɪ"
+	case ZHUI:
+		return "ʈʂweɪ"
+	case ZHUAN:
+		return "ʈʂwan"
+	case ZHUN:
+		return "ʈʂwən"
+	case ZHUANG:
+		return "ʈʂwɑŋ"
+	case CHA:
+		return "ʈʂʰa"
+	case CHE:
+		return "ʈʂʰɤ"
+	case CHI:
+		return "ʈʂʰɻ̩"
+	case CHAI:
+		return "ʈʂʰaɪ"
+	case CHAO:
+		return "ʈʂʰɑʊ"
+	case CHOU:
+		return "ʈʂʰoʊ"
+	case CHAN:
+		return "ʈʂʰan"
+	case CHEN:
+		return "ʈʂʰən"
+	case CHANG:
+		return "ʈʂʰɑŋ"
+	case CHENG:
+		return "ʈʂʰəŋ"
+	case CHONG:
+		return "ʈʂʰʊŋ"
+	case CHU:
+		return "ʈʂʰu"
+	case CHUA:
+		return "ʈʂʰwa"
+	case CHUO:
+		return "ʈʂʰwo"
+	case CHUAI:
+		return "ʈʂʰwaɪ"
+	case CHUI:
+		return "ʈʂʰweɪ"
+	case CHUAN:
+		return "ʈʂʰwan"
+	case CHUN:
+		return "ʈʂʰwən"
+	case CHUANG:
+		return "ʈʂʰwɑŋ"
+	case SHA:
+		return "ʂa"
+	case SHE:
+		return "ʂɤ"
+	case SHI:
+		return "ʂɻ̩"
+	case SHAI:
+		return "ʂaɪ"
+	case SHEI:
+		return "ʂeɪ"
+	case SHAO:
+		return "ʂɑʊ"
+	case SHOU:
+		return "ʂoʊ"
+	case SHAN:
+		return "ʂan"
+	case SHEN:
+		return "ʂən"
+	case SHANG:
+		return "ʂɑŋ"
+	case SHENG:
+		return "ʂəŋ"
+	case SHU:
+		return "ʂu"
+	case SHUA:
+		return "ʂwa"
+	case SHUO:
+		return "ʂwo"
+	case SHUAI:
+		return "ʂwaɪ"
+	case SHUI:
+		return "ʂweɪ"
+	case SHUAN:
+		return "ʂwan"
+	case SHUN:
+		return "ʂwən"
+	case SHUANG:
+		return "ʂwɑŋ"
+	case RE:
+		return "ʐɤ"
+	case RI:
+		return "ʐɻ̩"
+	case RAO:
+		return "ʐɑʊ"
+	case ROU:
+		return "ʐoʊ"
+	case RAN:
+		return "ʐan"
+	case REN:
+		return "ʐən"
+	case RANG:
+		return "ʐɑŋ"
+	case RENG:
+		return "ʐəŋ"
+	case RONG:
+		return "ʐʊŋ"
+	case RU:
+		return "ʐu"
+	case RUA:
+		return "ʐwa"
+	case RUO:
+		return "ʐwo"
+	case RUI:
+		return "ʐweɪ"
+	case RUAN:
+		return "ʐwan"
+	case RUN:
+		return "ʐwən"
+	case JI:
+		return "tɕi"
+	case JIA:
+		return "tɕja"
+	case JIAO:
+		return "tɕjɑʊ"
+	case JIE:
+		return "tɕjɛ"
+	case JIU:
+		return "tɕjoʊ"
+	case JIAN:
+		return "tɕjɛn"
+	case JIN:
+		return "tɕin"
+	case JIANG:
+		return "tɕjɑŋ"
+	case JING:
+		return "tɕiŋ"
+	case JIONG:
+		return "tɕjʊŋ"
+	case JU:
+		return "tɕy"
+	case JUE:
+		return "tɕɥɛ"
+	case JUAN:
+		return "tɕɥɛn"
+	case JUN:
+		return "tɕyn"
+	case QI:
+		return "tɕʰi"
+	case QIA:
+		return "tɕʰja"
+	case QIAO:
+		return "tɕʰjɑʊ"
+	case QIE:
+		return "tɕʰjɛ"
+	case QIU:
+		return "tɕʰjoʊ"
+	case QIAN:
+		return "tɕʰjɛn"
+	case QIN:
+		return "tɕʰin"
+	case QIANG:
+		return "tɕʰjɑŋ"
+	case QING:
+		return "tɕʰiŋ"
+	case QIONG:
+		return "tɕʰjʊŋ"
+	case QU:
+		return "tɕʰy"
+	case QUE:
+		return "tɕʰɥɛ"
+	case QUAN:
+		return "tɕʰɥɛn"
+	case QUN:
+		return "tɕʰyn"
+	case XI:
+		return "ɕi"
+	case XIA:
+		return "ɕja"
+	case XIAO:
+		return "ɕjɑʊ"
+	case XIE:
+		return "ɕjɛ"
+	case XIU:
+		return "ɕjoʊ"
+	case XIAN:
+		return "ɕjɛn"
+	case XIN:
+		return "ɕin"
+	case XIANG:
+		return "ɕjɑŋ"
+	case XING:
+		return "ɕiŋ"
+	case XIONG:
+		return "ɕjʊŋ"
+	case XU:
+		return "ɕy"
+	case XUE:
+		return "ɕɥɛ"
+	case XUAN:
+		return "ɕɥɛn"
+	case XUN:
+		return "ɕyn"
+	case FIAO:
+		return "fjɑʊ"
+	case N:
+		return "n̩"
+	case NG:
+		return "ŋ̍"
+	case M:
+		return "m̩"
+	case YO:
+		return "jo"
+	case HM:
+		return "hm̩"
+	case LO:
+		return "lo"
+	case EI:
+		return "eɪ"
+	case NUN:
+		return "nwən"
+	case CEI:
+		return "tsʰeɪ"
+	case WONG:
+		return "wʊŋ"
+	case DIN:
+		return "tin"
+	case BIANG:
+		return "pjɑŋ"
+	case R:
+		return "ɻ"
+	default:
+		return "?"
+	}
+}
+
+const numSounds = 421
@@ -0,0 +1,277 @@
+package pinyin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// grNeutral marks a syllable of the neutral tone in Gwoyeu Romatzyh.
+const grNeutral = '.'
+
+// GR renders a pinyin using Gwoyeu Romatzyh. The tone is expressed by
+// the spelling of the syllable (tonal spelling), a syllable of the
+// neutral tone is written in its basic form, preceded by a dot.
+func (p Pinyin) GR() string {
+	c, t := p.Decode()
+	if t == Neutral {
+		return string(grNeutral) + c.gr()
+	}
+	return grTonal(c.gr(), t)
+}
+
+// grInitials are the initials of Gwoyeu Romatzyh, longer ones first.
+var grInitials = []string{
+	"tz", "ts", "ch", "sh",
+	"b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "j", "r", "s",
+}
+
+// splitGR splits the basic form of a syllable into initial and final.
+func splitGR(basic string) (string, string) {
+	for _, initial := range grInitials {
+		if len(basic) > len(initial) &&
+			strings.HasPrefix(basic, initial) &&
+			strings.IndexByte("aeiouy", basic[len(initial)]) >= 0 {
+			return initial, basic[len(initial):]
+		}
+	}
+	return "", basic
+}
+
+// grTonal derives the spelling of a syllable in a tone from its basic
+// form, which is the spelling of the flat tone:
+//
+//   - Flat: syllables with the initial m, n, l or r insert an h after
+//     the initial (mha), all others keep the basic form (ba).
+//   - Rising: syllables with the initial m, n, l or r keep the basic
+//     form (ma). Otherwise, the medials i and u become y and w (jyi,
+//     hwa), and an r is inserted after the vowels of finals without
+//     medial (bar, bair, barn).
+//   - Low: single vowels are doubled (baa, jii), the medials i and u
+//     become e and o (jea, goa), and the endings i and u become e and o
+//     (mae, hao). Other finals double their first vowel (been, eel).
+//   - Falling: the endings i, u, n and ng become y, w, nn and nq (may,
+//     haw, bann, banq), el becomes ell, and all other finals append an
+//     h (bah).
+//
+// Without initial, the medials i and u become y and w in the low and
+// falling tone as well (yea, woa, yah, wah). Syllables without vowel,
+// like m or ng, are spelled the same in all tones.
+func grTonal(basic string, t Tone) string {
+	initial, final := splitGR(basic)
+	if strings.IndexByte("aeiouy", final[0]) < 0 {
+		return basic
+	}
+	sonorant := false
+	switch initial {
+	case "m", "n", "l", "r":
+		sonorant = true
+	}
+	var spelling string
+	switch t {
+	case Flat:
+		if sonorant {
+			return initial + "h" + final
+		}
+		return basic
+	case Rising:
+		if sonorant {
+			return basic
+		}
+		spelling = grRising(final)
+	case Low:
+		spelling = grLow(final)
+	case Falling:
+		spelling = grFalling(final)
+	default:
+		return basic
+	}
+	if initial == "" && t != Rising {
+		spelling = grZeroInitial(final, spelling)
+	}
+	return initial + spelling
+}
+
+func grRising(final string) string {
+	switch {
+	case final == "y":
+		return "yr"
+	case final == "i":
+		return "yi"
+	case final == "u":
+		return "wu"
+	case final[0] == 'i':
+		return "y" + final[1:]
+	case final[0] == 'u':
+		return "w" + final[1:]
+	}
+	// after the vowels, before an ending n, ng or l
+	n := 1
+	for n < len(final) && strings.IndexByte("aeiou", final[n]) >= 0 {
+		n++
+	}
+	return final[:n] + "r" + final[n:]
+}
+
+func grLow(final string) string {
+	switch final {
+	case "y":
+		return "yy"
+	case "i", "in", "ing", "u":
+		return final[:1] + final
+	case "ie", "uo":
+		return final + final[1:]
+	case "ai":
+		return "ae"
+	case "au":
+		return "ao"
+	}
+	switch final[0] {
+	case 'i':
+		return "e" + final[1:]
+	case 'u':
+		return "o" + final[1:]
+	}
+	return final[:1] + final
+}
+
+func grFalling(final string) string {
+	switch {
+	case final == "y" || final == "i" || final == "u" || final == "iu":
+		return final + "h"
+	case strings.HasSuffix(final, "i"):
+		return final[:len(final)-1] + "y"
+	case strings.HasSuffix(final, "u"):
+		return final[:len(final)-1] + "w"
+	case strings.HasSuffix(final, "ng"):
+		return final[:len(final)-2] + "nq"
+	case strings.HasSuffix(final, "n"):
+		return final + "n"
+	case strings.HasSuffix(final, "l"):
+		return final + "l"
+	}
+	return final + "h"
+}
+
+// grZeroInitial writes the medials i and u of a spelling of the low or
+// falling tone as y and w, for syllables without initial.
+func grZeroInitial(final, spelling string) string {
+	switch {
+	case final == "i" || final == "in" || final == "ing":
+		return "y" + spelling
+	case final == "u":
+		return "w" + spelling
+	case spelling[0] == 'i':
+		return "y" + spelling[1:]
+	case spelling[0] == 'u':
+		return "w" + spelling[1:]
+	case final[0] == 'i':
+		return "y" + spelling
+	case final[0] == 'u':
+		return "w" + spelling
+	}
+	return spelling
+}
+
+// grSpellings maps the tonal spellings of all sounds to their pinyin.
+// If spellings coincide, the first sound and tone wins.
+var grSpellings, maxGrTonalLength = func() (map[string]Pinyin, int) {
+	result := make(map[string]Pinyin)
+	maxLength := 0
+	for s := Sound(0); s < numSounds; s++ {
+		for t := Flat; t <= Falling; t++ {
+			spelling := grTonal(s.gr(), t)
+			if _, ok := result[spelling]; ok {
+				continue
+			}
+			result[spelling] = New(s, t)
+			if l := len([]rune(spelling)); l > maxLength {
+				maxLength = l
+			}
+		}
+	}
+	return result, maxLength
+}()
+
+// ParseGR parses a single pinyin written in Gwoyeu Romatzyh from a
+// slice of runes. The tone is derived from the tonal spelling, a
+// syllable preceded by a dot (. or ·) has the neutral tone and may be
+// written in its basic or any tonal spelling. Returns false if no
+// pinyin could be parsed, otherwise true, the parsed pinyin and the
+// remaining slice of runes.
+func ParseGR(str []rune) (bool, Pinyin, []rune) {
+	for len(str) > 0 && str[0] == ' ' {
+		str = str[1:]
+	}
+	if len(str) > 0 && (str[0] == grNeutral || str[0] == '·') {
+		ok, spelling, _, rest := longestMatch(str[1:], maxGrTonalLength,
+			lowerCase, func(sp string) bool {
+				_, tonal := grSpellings[sp]
+				basic, _ := grSound(sp)
+				return tonal || basic
+			})
+		if !ok {
+			return false, 0, nil
+		}
+		sound, _ := grSpellings[spelling].Decode()
+		if ok, basic := grSound(spelling); ok {
+			sound = basic
+		}
+		return true, New(sound, Neutral), rest
+	}
+	ok, spelling, _, rest := longestMatch(str, maxGrTonalLength,
+		lowerCase, func(sp string) bool {
+			_, ok := grSpellings[sp]
+			return ok
+		})
+	if !ok {
+		return false, 0, nil
+	}
+	return true, grSpellings[spelling], rest
+}
+
+// ParseManyGR parses many runes written in Gwoyeu Romatzyh until all
+// runes are consumed, or a parse error is encountered.
+func ParseManyGR(str []rune) ([]Pinyin, []rune) {
+	var result []Pinyin
+	for {
+		if len(str) == 0 {
+			return result, nil
+		}
+		ok, p, rest := ParseGR(str)
+		if !ok {
+			return result, str
+		}
+		result = append(result, p)
+		str = rest
+	}
+}
+
+// RenderManyGR renders a slice of pinyins using Gwoyeu Romatzyh,
+// separating the syllables by spaces.
+func RenderManyGR(ps []Pinyin) string {
+	var buf strings.Builder
+	RenderManyGRWriter(&buf, ps)
+	return buf.String()
+}
+
+// RenderManyGRWriter renders a slice of pinyins using Gwoyeu Romatzyh
+// to a writer.
+func RenderManyGRWriter(w io.Writer, ps []Pinyin) (int, error) {
+	c := 0
+	for i, p := range ps {
+		if i != 0 {
+			n, err := fmt.Fprint(w, " ")
+			c += n
+			if err != nil {
+				return c, err
+			}
+		}
+		n, err := fmt.Fprint(w, p.GR())
+		c += n
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,89 @@
+package pinyin
+
+import "testing"
+
+func TestGR(t *testing.T) {
+	tests := []struct {
+		Pinyin string
+		GR     string
+	}{
+		{"ma1", "mha"},
+		{"ma2", "ma"},
+		{"ma3", "maa"},
+		{"ma4", "mah"},
+		{"ma5", ".ma"},
+		{"guo2", "gwo"},
+		{"yu3", "yeu"},
+		{"zi4", "tzyh"},
+		{"shi2", "shyr"},
+		{"xue2", "shyue"},
+		{"ye3", "yee"},
+		{"hao3", "hao"},
+		{"mai4", "may"},
+		{"dui4", "duey"},
+		{"jiu3", "jeou"},
+		{"ren2", "ren"},
+		{"ri4", "ryh"},
+		{"er2", "erl"},
+		{"nü3", "neu"},
+		{"yin2", "yn"},
+		{"wo3", "woo"},
+		{"zhong1", "jong"},
+		{"yang4", "yanq"},
+		{"wan4", "wann"},
+		{"bai2", "bair"},
+		{"fei2", "feir"},
+		{"tou2", "tour"},
+		{"hao2", "haur"},
+		{"shei2", "sheir"},
+		{"fan2", "farn"},
+		{"hong2", "horng"},
+	}
+	for _, test := range tests {
+		t.Run(test.Pinyin, func(t *testing.T) {
+			ok, p, rest := Parse([]rune(test.Pinyin))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse pinyin")
+			}
+			if gr := p.GR(); gr != test.GR {
+				t.Errorf("wrong gr: %q", gr)
+			}
+			ok, parsed, rest := ParseGR([]rune(test.GR))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse gr")
+			}
+			if parsed != p {
+				t.Errorf("wrong result: %s", parsed)
+			}
+		})
+	}
+}
+
+func TestParseManyGR(t *testing.T) {
+	result, rest := ParseManyGR([]rune("Gwoyeu Romatzyh"))
+	if RenderMany(result) != "guóyǔ" {
+		t.Errorf("wrong result: %s", RenderMany(result))
+	}
+	if string(rest) != " Romatzyh" {
+		t.Errorf("wrong rest: %q", string(rest))
+	}
+	result, _ = ParseManyGR([]rune("Jong·gwo .de"))
+	if gr := RenderManyGR(result); gr != "jong .guo .de" {
+		t.Errorf("wrong rendering: %q", gr)
+	}
+}
+
+func TestGRRoundTrip(t *testing.T) {
+	for s := Sound(0); s < numSounds; s++ {
+		for tone := Neutral; tone <= Falling; tone++ {
+			p := New(s, tone)
+			ok, parsed, rest := ParseGR([]rune(p.GR()))
+			if !ok || len(rest) != 0 {
+				t.Fatalf("failed to parse %q", p.GR())
+			}
+			if parsed.GR() != p.GR() {
+				t.Errorf("%s parsed as %s", p, parsed)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package pinyin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// IPATones selects how the tone of an IPA transcription is marked.
+type IPATones int
+
+const (
+	// ToneLetters marks the tone with Chao tone letters, like ˥˩.
+	ToneLetters IPATones = iota
+	// ToneNumbers marks the tone with superscript Chao numbers, like
+	// ⁵¹.
+	ToneNumbers
+)
+
+// ipaToneLetters and ipaToneNumbers are the contours of the flat,
+// rising, low and falling tone.
+var (
+	ipaToneLetters = [4]string{"˥", "˧˥", "˨˩˦", "˥˩"}
+	ipaToneNumbers = [4]string{"⁵⁵", "³⁵", "²¹⁴", "⁵¹"}
+)
+
+// IPA renders a pinyin as broad transcription in the International
+// Phonetic Alphabet, followed by the contour of its tone. The neutral
+// tone is left unmarked.
+func (p Pinyin) IPA(tones IPATones) string {
+	c, t := p.Decode()
+	if t == Neutral {
+		return c.ipa()
+	}
+	if tones == ToneNumbers {
+		return c.ipa() + ipaToneNumbers[t-1]
+	}
+	return c.ipa() + ipaToneLetters[t-1]
+}
+
+// RenderManyIPA renders a slice of pinyins in IPA, separating the
+// syllables by spaces.
+func RenderManyIPA(ps []Pinyin, tones IPATones) string {
+	var buf strings.Builder
+	RenderManyIPAWriter(&buf, ps, tones)
+	return buf.String()
+}
+
+// RenderManyIPAWriter renders a slice of pinyins in IPA to a writer.
+func RenderManyIPAWriter(w io.Writer, ps []Pinyin, tones IPATones) (int, error) {
+	c := 0
+	for i, p := range ps {
+		if i != 0 {
+			n, err := fmt.Fprint(w, " ")
+			c += n
+			if err != nil {
+				return c, err
+			}
+		}
+		n, err := fmt.Fprint(w, p.IPA(tones))
+		c += n
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,84 @@
+package pinyin
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestIPA(t *testing.T) {
+	tests := []struct {
+		Pinyin  string
+		Letters string
+		Numbers string
+	}{
+		{"ma1", "ma˥", "ma⁵⁵"},
+		{"guo2", "kwo˧˥", "kwo³⁵"},
+		{"ni3", "ni˨˩˦", "ni²¹⁴"},
+		{"shi4", "ʂɻ̩˥˩", "ʂɻ̩⁵¹"},
+		{"zi3", "tsɹ̩˨˩˦", "tsɹ̩²¹⁴"},
+		{"de5", "tɤ", "tɤ"},
+		{"xue2", "ɕɥɛ˧˥", "ɕɥɛ³⁵"},
+		{"er4", "ɚ˥˩", "ɚ⁵¹"},
+		{"r5", "ɻ", "ɻ"},
+		{"ng2", "ŋ̍˧˥", "ŋ̍³⁵"},
+	}
+	for _, test := range tests {
+		t.Run(test.Pinyin, func(t *testing.T) {
+			ok, p, rest := Parse([]rune(test.Pinyin))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse pinyin")
+			}
+			if ipa := p.IPA(ToneLetters); ipa != test.Letters {
+				t.Errorf("wrong IPA with tone letters: %q", ipa)
+			}
+			if ipa := p.IPA(ToneNumbers); ipa != test.Numbers {
+				t.Errorf("wrong IPA with tone numbers: %q", ipa)
+			}
+		})
+	}
+}
+
+func TestRenderManyIPA(t *testing.T) {
+	result, _ := ParseMany([]rune("Zhōngguó"))
+	if ipa := RenderManyIPA(result, ToneLetters); ipa != "ʈʂʊŋ˥ kwo˧˥" {
+		t.Errorf("wrong rendering: %q", ipa)
+	}
+}
+
+// TestIPAGolden compares the transcription of all sounds in all tones
+// with testdata/ipa.golden. Run with -update to rewrite it.
+func TestIPAGolden(t *testing.T) {
+	var buf bytes.Buffer
+	for s := Sound(0); s < numSounds; s++ {
+		for tone := Neutral; tone <= Falling; tone++ {
+			p := New(s, tone)
+			fmt.Fprintf(&buf, "%s\t%s\t%s\n", p,
+				p.IPA(ToneLetters), p.IPA(ToneNumbers))
+		}
+	}
+	golden := filepath.Join("testdata", "ipa.golden")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), expected) {
+		exp := bytes.Split(expected, []byte("\n"))
+		for i, ln := range bytes.Split(buf.Bytes(), []byte("\n")) {
+			if i >= len(exp) || !bytes.Equal(ln, exp[i]) {
+				t.Fatalf("line %d differs from %s: %q", i+1, golden, ln)
+			}
+		}
+		t.Fatalf("output differs from %s", golden)
+	}
+}
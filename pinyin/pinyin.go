@@ -22,6 +22,13 @@ type Sound uint16
 // Tone adds a flat, rising, low or falling component to a sound.
 type Tone byte
 
+// Initial is the consonant a sound starts with, or NoInitial.
+type Initial byte
+
+// Final is the part of a sound following the initial, made up of
+// medial, vowel and ending.
+type Final byte
+
 const (
 	Neutral Tone = 0
 	Flat    Tone = 1
@@ -183,6 +190,12 @@ type parser struct {
 	State parserState
 	Tone  Tone
 	Done  bool
+	// Erhua enables parsing a rhotic suffix after a syllable
+	Erhua bool
+	// R is set once a rhotic suffix was parsed
+	R bool
+	// RDone is set once no further rune may follow a suffix
+	RDone bool
 }
 
 func (p *parser) setTone(t Tone) bool {
@@ -194,13 +207,26 @@ func (p *parser) setTone(t Tone) bool {
 }
 
 func (p *parser) Advance(r rune) bool {
+	r = unicode.ToLower(r)
+	if p.R {
+		return p.advanceR(r)
+	}
 	if p.Done {
-		return false
+		return p.Erhua && r == 'r' && p.startR()
+	}
+	if p.Erhua && r == 'r' {
+		// r continues syllables like er, otherwise it's a suffix
+		if ok, nxt := p.State.next(r); ok {
+			p.State = nxt
+			return true
+		}
+		return p.startR()
 	}
-	r = unicode.ToLower(r)
 	switch r {
 	case '\'':
 		p.Done = true
+		// no rhotic suffix after a syllable separator
+		p.RDone = true
 		return true
 	case 'ā':
 		if !p.setTone(Flat) {
@@ -366,6 +392,36 @@ func (p *parser) Advance(r rune) bool {
 	return true
 }
 
+// startR parses a rhotic suffix after a complete syllable.
+func (p *parser) startR() bool {
+	ok, sound := p.State.Sound()
+	if !ok || sound == R || p.RDone {
+		return false
+	}
+	p.R = true
+	return true
+}
+
+// advanceR parses a tone number after a rhotic suffix. The number
+// gives the tone of the syllable (wanr2), unless it is 5, which is the
+// neutral tone of the suffix in CEDICT notation (wan2r5).
+func (p *parser) advanceR(r rune) bool {
+	if p.RDone {
+		return false
+	}
+	switch r {
+	case '5':
+	case '1', '2', '3', '4':
+		if !p.setTone(Tone(r - '0')) {
+			return false
+		}
+	default:
+		return false
+	}
+	p.RDone = true
+	return true
+}
+
 func (p *parser) Result() (bool, Pinyin) {
 	ok, sound := p.State.Sound()
 	if !ok {
@@ -408,7 +464,7 @@ func ParseMany(str []rune) ([]Pinyin, []rune) {
 		}
 		ok, p, rest := Parse(str)
 		if !ok {
-			return result, rest
+			return result, str
 		}
 		result = append(result, p)
 		str = rest
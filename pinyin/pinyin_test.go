@@ -79,3 +79,70 @@ func TestParseMany(t *testing.T) {
 		})
 	}
 }
+
+func TestParseManyRest(t *testing.T) {
+	result, rest := ParseMany([]rune("zhong1guoxyz"))
+	if RenderMany(result) != "zhōngguo" {
+		t.Errorf("wrong result: %+v", result)
+	}
+	if string(rest) != "xyz" {
+		t.Errorf("wrong rest: %q", string(rest))
+	}
+}
+
+func TestInitialFinal(t *testing.T) {
+	tests := []struct {
+		Sound   Sound
+		Initial Initial
+		Final   Final
+	}{
+		{ZHANG, InitialZH, FinalANG},
+		{BA, InitialB, FinalA},
+		{A, NoInitial, FinalA},
+		{YOU, NoInitial, FinalIU},
+		{LIU, InitialL, FinalIU},
+		{WEI, NoInitial, FinalUI},
+		{YU, NoInitial, FinalÜ},
+		{JU, InitialJ, FinalÜ},
+		{LÜ, InitialL, FinalÜ},
+		{LU, InitialL, FinalU},
+		{XUE, InitialX, FinalÜE},
+		{NÜE, InitialN, FinalÜE},
+		{SHI, InitialSH, FinalI},
+		{NG, NoInitial, FinalNG},
+		{HM, InitialH, FinalM},
+		{ER, NoInitial, FinalER},
+	}
+	for _, test := range tests {
+		t.Run(test.Sound.String(), func(t *testing.T) {
+			if i := test.Sound.Initial(); i != test.Initial {
+				t.Errorf("wrong initial: %s", i)
+			}
+			if f := test.Sound.Final(); f != test.Final {
+				t.Errorf("wrong final: %s", f)
+			}
+		})
+	}
+	if s := NoInitial.String(); s != "∅" {
+		t.Errorf("wrong rendering of no initial: %q", s)
+	}
+	if s := FinalÜAN.String(); s != "üan" {
+		t.Errorf("wrong rendering of final: %q", s)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	for s := Sound(0); s < numSounds; s++ {
+		composed, ok := Compose(s.Initial(), s.Final())
+		if !ok || composed != s {
+			t.Errorf("%s decomposed into %s+%s, composed to %s",
+				s, s.Initial(), s.Final(), composed)
+		}
+	}
+	if _, ok := Compose(InitialB, FinalÜ); ok {
+		t.Error("composed nonexistent sound bü")
+	}
+	if s, ok := Compose(InitialQ, FinalÜAN); !ok || s != QUAN {
+		t.Errorf("wrong composition of q+üan: %s", s)
+	}
+}
@@ -0,0 +1,120 @@
+package pinyin
+
+import "unicode"
+
+// longestMatch finds the longest prefix of str that is a known
+// spelling, spanning at most maxLength letters. normalize maps a rune
+// to the letter it stands for and the tone it marks, runes mapped to
+// 0 only mark a tone. Returns the spelling, the tone marked within it
+// (Neutral if none) and the remaining runes, or false if no prefix is
+// a known spelling.
+func longestMatch(str []rune, maxLength int,
+	normalize func(rune) (rune, Tone),
+	known func(string) bool) (bool, string, Tone, []rune) {
+	var letters []rune
+	// ends[i] is the number of runes spanned by the first i+1
+	// letters, including following tone marks
+	var ends []int
+	var tones []Tone
+	for i, r := range str {
+		letter, tone := normalize(r)
+		if letter == 0 {
+			if len(letters) == 0 || tone == Neutral {
+				break
+			}
+			ends[len(ends)-1] = i + 1
+			tones[len(tones)-1] = tone
+			continue
+		}
+		if len(letters) == maxLength {
+			break
+		}
+		letters = append(letters, letter)
+		ends = append(ends, i+1)
+		tones = append(tones, tone)
+	}
+	for l := len(letters); l > 0; l-- {
+		spelling := string(letters[:l])
+		if !known(spelling) {
+			continue
+		}
+		tone := Neutral
+		for _, t := range tones[:l] {
+			if t != Neutral {
+				tone = t
+			}
+		}
+		return true, spelling, tone, str[ends[l-1]:]
+	}
+	return false, "", Neutral, nil
+}
+
+// lowerCase normalizes a rune to lower case without marking a tone.
+func lowerCase(r rune) (rune, Tone) {
+	return unicode.ToLower(r), Neutral
+}
+
+// markedLetters are the precomposed forms of letters with the marks
+// of the flat, rising, low and falling tone, 0 if there is none.
+var markedLetters = map[rune][4]rune{
+	'a': {'ā', 'á', 'ǎ', 'à'},
+	'e': {'ē', 'é', 'ě', 'è'},
+	'i': {'ī', 'í', 'ǐ', 'ì'},
+	'o': {'ō', 'ó', 'ǒ', 'ò'},
+	'u': {'ū', 'ú', 'ǔ', 'ù'},
+	'ü': {'ǖ', 'ǘ', 'ǚ', 'ǜ'},
+	'm': {0, 'ḿ', 0, 0},
+	'n': {0, 'ń', 'ň', 'ǹ'},
+	'r': {0, 'ŕ', 'ř', 0},
+	'z': {0, 'ź', 'ž', 0},
+}
+
+// combiningMarks are the combining diacritics of the flat, rising,
+// low and falling tone.
+var combiningMarks = [4]rune{'\u0304', '\u0301', '\u030c', '\u0300'}
+
+type markedLetter struct {
+	Letter rune
+	Tone   Tone
+}
+
+// unmarkedLetters maps the precomposed forms of [markedLetters] to
+// their letter and tone.
+var unmarkedLetters = func() map[rune]markedLetter {
+	result := make(map[rune]markedLetter)
+	for letter, marked := range markedLetters {
+		for i, m := range marked {
+			if m != 0 {
+				result[m] = markedLetter{letter, Tone(i + 1)}
+			}
+		}
+	}
+	return result
+}()
+
+// markLetter renders a letter with the mark of a tone, using a
+// combining diacritic if there is no precomposed form.
+func markLetter(letter rune, t Tone) string {
+	if t == Neutral {
+		return string(letter)
+	}
+	if m := markedLetters[letter][t-1]; m != 0 {
+		return string(m)
+	}
+	return string(letter) + string(combiningMarks[t-1])
+}
+
+// unmarkLetter normalizes a rune to the lower case letter without tone
+// mark and the marked tone. Combining tone marks are mapped to 0.
+func unmarkLetter(r rune) (rune, Tone) {
+	r = unicode.ToLower(r)
+	if u, ok := unmarkedLetters[r]; ok {
+		return u.Letter, u.Tone
+	}
+	for i, m := range combiningMarks {
+		if r == m {
+			return 0, Tone(i + 1)
+		}
+	}
+	return r, Neutral
+}
@@ -0,0 +1,210 @@
+package pinyin
+
+import "strings"
+
+// Syllable is a syllable of spoken text. Char is the character it's
+// written with (0 if unknown), Pinyin its underlying pronunciation as
+// given by a dictionary, and Surface its pronunciation as spoken after
+// tone sandhi. Classifier marks the syllable of a classifier (measure
+// word), which is never reduplicated like a verb.
+type Syllable struct {
+	Char       rune
+	Pinyin     Pinyin
+	Surface    Pinyin
+	Classifier bool
+}
+
+// Underlying returns the underlying pinyins of syllables.
+func Underlying(ss []Syllable) []Pinyin {
+	result := make([]Pinyin, len(ss))
+	for i, s := range ss {
+		result[i] = s.Pinyin
+	}
+	return result
+}
+
+// Surface returns the spoken pinyins of syllables.
+func Surface(ss []Syllable) []Pinyin {
+	result := make([]Pinyin, len(ss))
+	for i, s := range ss {
+		result[i] = s.Surface
+	}
+	return result
+}
+
+// Characters taking part in sandhi rules.
+const (
+	charYi = '一'
+	charBu = '不'
+	charDi = '第'
+)
+
+// digits are the chinese numerals read as digits, units the numerals
+// of powers of ten.
+const (
+	digits = "〇零一二三四五六七八九两兩"
+	units  = "十拾百佰千仟万萬亿億"
+)
+
+// Sandhi sets the surface pronunciation of a phrase, split into
+// prosodic words (e.g. by word segmentation). The Char and Pinyin of
+// every syllable are used, Surface is overwritten. The rules are
+// applied in this order:
+//
+//   - Reduplication: a verb repeated as neighbouring single-syllable
+//     words (看 看), or with the words 一 or 不 in between (看 一 看,
+//     好 不 好), has its second part in the neutral tone. Numerals and
+//     classifiers are not reduplicated verbs (三 一 三, 个 一 个), and
+//     reduplications within a word keep the tones given by the
+//     dictionary.
+//   - 一: before a falling or neutral tone, 一 has the rising tone (一
+//     个, 一定), before other tones the falling tone (一天, 一起). It
+//     keeps the flat tone at the end of a phrase, as ordinal (第一), as
+//     part of a number read digit by digit (一九) and between repeated
+//     syllables (看一看 as a single word).
+//   - 不: before a falling tone, 不 has the rising tone (不要).
+//   - Third tone: in a run of low tones, all but the last have the
+//     rising tone (你好, 展览馆). Within words first, then between
+//     words from right to left, so that the tighter group on the
+//     right changes first (我很好 is spoken as wǒ hén hǎo).
+func Sandhi(words [][]Syllable) {
+	// the phrase as a flat sequence of syllables, with the word
+	// boundaries
+	var phrase []*Syllable
+	var starts []int
+	for _, word := range words {
+		starts = append(starts, len(phrase))
+		for i := range word {
+			word[i].Surface = word[i].Pinyin
+			phrase = append(phrase, &word[i])
+		}
+	}
+	reduplicate(words)
+	for i, s := range phrase {
+		switch s.Char {
+		case charYi:
+			yiSandhi(phrase, i)
+		case charBu:
+			buSandhi(phrase, i)
+		}
+	}
+	for _, word := range words {
+		thirdToneSandhi(word)
+	}
+	for w := len(words) - 2; w >= 0; w-- {
+		word := words[w]
+		next := starts[w+1]
+		if len(word) == 0 || next >= len(phrase) {
+			continue
+		}
+		if toneOf(word[len(word)-1].Surface) == Low &&
+			toneOf(phrase[next].Surface) == Low {
+			setTone(&word[len(word)-1], Rising)
+		}
+	}
+}
+
+// reduplicate applies the neutral tone to reduplicated verbs. Only
+// neighbouring words of a single syllable are considered.
+func reduplicate(words [][]Syllable) {
+	for w := 0; w+1 < len(words); w++ {
+		if len(words[w]) != 1 || len(words[w+1]) != 1 {
+			continue
+		}
+		verb, next := &words[w][0], &words[w+1][0]
+		if !reduplicable(verb) {
+			continue
+		}
+		if sameSyllable(verb, next) {
+			setTone(next, Neutral)
+			continue
+		}
+		if w+2 == len(words) || len(words[w+2]) != 1 ||
+			!sameSyllable(verb, &words[w+2][0]) {
+			continue
+		}
+		switch next.Char {
+		case charYi:
+			setTone(next, Neutral)
+			setTone(&words[w+2][0], Neutral)
+		case charBu:
+			setTone(next, Neutral)
+		}
+	}
+}
+
+// reduplicable tells whether a syllable may be a reduplicated verb.
+func reduplicable(s *Syllable) bool {
+	return s.Char != 0 && !s.Classifier && !isNumeral(s.Char)
+}
+
+func sameSyllable(a, b *Syllable) bool {
+	return a.Char == b.Char && a.Pinyin == b.Pinyin
+}
+
+// yiSandhi applies the tone changes of 一 at position i.
+func yiSandhi(phrase []*Syllable, i int) {
+	s := phrase[i]
+	if s.Surface != s.Pinyin || toneOf(s.Pinyin) != Flat ||
+		i+1 == len(phrase) {
+		return
+	}
+	if i > 0 && (phrase[i-1].Char == charDi ||
+		isNumeral(phrase[i-1].Char)) {
+		return
+	}
+	next := phrase[i+1]
+	if strings.ContainsRune(digits, next.Char) {
+		return
+	}
+	if i > 0 && reduplicable(phrase[i-1]) &&
+		sameSyllable(phrase[i-1], next) {
+		// reduplications like 看一看 not handled by reduplicate
+		return
+	}
+	switch toneOf(next.Pinyin) {
+	case Falling, Neutral:
+		// a neutral tone after 一 is mostly a reduced falling one,
+		// like in 一个
+		setTone(s, Rising)
+	default:
+		setTone(s, Falling)
+	}
+}
+
+// buSandhi applies the tone change of 不 at position i.
+func buSandhi(phrase []*Syllable, i int) {
+	s := phrase[i]
+	if s.Surface != s.Pinyin || toneOf(s.Pinyin) != Falling ||
+		i+1 == len(phrase) {
+		return
+	}
+	if toneOf(phrase[i+1].Pinyin) == Falling {
+		setTone(s, Rising)
+	}
+}
+
+func isNumeral(r rune) bool {
+	return r != 0 && strings.ContainsRune(digits+units, r)
+}
+
+// thirdToneSandhi changes all but the last of a run of low tones in a
+// word to the rising tone.
+func thirdToneSandhi(word []Syllable) {
+	for i := 0; i+1 < len(word); i++ {
+		if toneOf(word[i].Surface) == Low &&
+			toneOf(word[i+1].Surface) == Low {
+			setTone(&word[i], Rising)
+		}
+	}
+}
+
+func toneOf(p Pinyin) Tone {
+	_, t := p.Decode()
+	return t
+}
+
+func setTone(s *Syllable, t Tone) {
+	sound, _ := s.Surface.Decode()
+	s.Surface = New(sound, t)
+}
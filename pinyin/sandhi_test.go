@@ -0,0 +1,106 @@
+package pinyin
+
+import (
+	"strings"
+	"testing"
+)
+
+// sandhiWords builds the words of a phrase from their characters and
+// numbered pinyin, like "你好 ni3hao3".
+func sandhiWords(t *testing.T, specs []string) [][]Syllable {
+	var words [][]Syllable
+	for _, spec := range specs {
+		chars, py, _ := strings.Cut(spec, " ")
+		ps, rest := ParseMany([]rune(py))
+		if len(rest) != 0 || len(ps) != len([]rune(chars)) {
+			t.Fatalf("invalid word %q", spec)
+		}
+		var word []Syllable
+		for i, c := range []rune(chars) {
+			word = append(word, Syllable{Char: c, Pinyin: ps[i]})
+		}
+		words = append(words, word)
+	}
+	return words
+}
+
+func TestSandhi(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Words   []string
+		Surface string
+	}{
+		{"third tone", []string{"你好 ni3hao3"}, "níhǎo"},
+		{"third tone run", []string{"展览馆 zhan3lan3guan3"}, "zhánlánguǎn"},
+		{"third tone between words",
+			[]string{"我 wo3", "很 hen3", "好 hao3"}, "wǒhénhǎo"},
+		{"third tone word and syllable",
+			[]string{"你 ni3", "很好 hen3hao3"}, "nǐhénhǎo"},
+		{"third tone neutral", []string{"姐姐 jie3jie5"}, "jiějie"},
+		{"yi before falling", []string{"一定 yi1ding4"}, "yídìng"},
+		{"yi before neutral", []string{"一个 yi1ge5"}, "yíge"},
+		{"yi before flat", []string{"一天 yi1tian1"}, "yìtiān"},
+		{"yi before low", []string{"一 yi1", "本 ben3"}, "yìběn"},
+		{"yi final", []string{"统一 tong3yi1"}, "tǒngyī"},
+		{"yi ordinal", []string{"第一 di4yi1", "次 ci4"}, "dìyīcì"},
+		{"yi number", []string{"十一 shi2yi1", "月 yue4"}, "shíyīyuè"},
+		{"yi digits", []string{"一九 yi1jiu3"}, "yījiǔ"},
+		{"yi hundred", []string{"一百 yi1bai3"}, "yìbǎi"},
+		{"bu before falling", []string{"不要 bu4yao4"}, "búyào"},
+		{"bu before low", []string{"不好 bu4hao3"}, "bùhǎo"},
+		{"reduplicated verb", []string{"看 kan4", "看 kan4"}, "kànkan"},
+		{"reduplicated low verb",
+			[]string{"想 xiang3", "想 xiang3"}, "xiǎngxiang"},
+		{"reduplicated word", []string{"天天 tian1tian1"}, "tiāntiān"},
+		{"verb yi verb", []string{"看 kan4", "一 yi1", "看 kan4"},
+			"kànyikan"},
+		{"verb bu verb", []string{"好 hao3", "不 bu4", "好 hao3"},
+			"hǎobuhǎo"},
+		{"numeral yi numeral", []string{"三 san1", "一 yi1", "三 san1"},
+			"sānyīsān"},
+		{"reduplication in word", []string{"看一看 kan4yi1kan4"},
+			"kànyīkàn"},
+		{"verb bu verb falling", []string{"是 shi4", "不 bu4", "是 shi4"},
+			"shìbushì"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			words := sandhiWords(t, test.Words)
+			Sandhi(words)
+			var surface, underlying []Pinyin
+			for _, word := range words {
+				surface = append(surface, Surface(word)...)
+				underlying = append(underlying, Underlying(word)...)
+			}
+			if str := RenderMany(surface); str != test.Surface {
+				t.Errorf("wrong surface: %s", str)
+			}
+			expected := sandhiWords(t, test.Words)
+			var original []Pinyin
+			for _, word := range expected {
+				original = append(original, Underlying(word)...)
+			}
+			if RenderMany(underlying) != RenderMany(original) {
+				t.Errorf("underlying pinyin changed: %s",
+					RenderMany(underlying))
+			}
+		})
+	}
+}
+
+func TestSandhiClassifier(t *testing.T) {
+	words := sandhiWords(t, []string{"个 ge4", "一 yi1", "个 ge4"})
+	for _, word := range words {
+		if word[0].Char == '个' {
+			word[0].Classifier = true
+		}
+	}
+	Sandhi(words)
+	var surface []Pinyin
+	for _, word := range words {
+		surface = append(surface, Surface(word)...)
+	}
+	if str := RenderMany(surface); str != "gèyígè" {
+		t.Errorf("wrong surface: %s", str)
+	}
+}
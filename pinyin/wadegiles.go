@@ -0,0 +1,111 @@
+package pinyin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// superscriptTones are the tone numbers of Wade-Giles.
+var superscriptTones = [4]rune{'¹', '²', '³', '⁴'}
+
+// WadeGiles renders a pinyin using the Wade-Giles romanization, with
+// the tone as superscript number. The neutral tone has no number.
+func (p Pinyin) WadeGiles() string {
+	c, t := p.Decode()
+	if t == Neutral {
+		return c.wadeGiles()
+	}
+	return c.wadeGiles() + string(superscriptTones[t-1])
+}
+
+// wadeGilesLetter normalizes a rune to lower case and the various
+// forms of the aspiration apostrophe to '.
+func wadeGilesLetter(r rune) (rune, Tone) {
+	switch r {
+	case '’', '‘', 'ʻ', 'ʼ', '`':
+		return '\'', Neutral
+	}
+	return unicode.ToLower(r), Neutral
+}
+
+// ParseWadeGiles parses a single pinyin written in Wade-Giles from a
+// slice of runes. The tone is read from a following tone number,
+// either as superscript or as digit. Syllables without tone number
+// have the neutral tone. Apostrophes may be written as ' or ’, the
+// circumflex of ê and the breve of ŭ may be omitted. Returns false if
+// no pinyin could be parsed, otherwise true, the parsed pinyin and the
+// remaining slice of runes.
+func ParseWadeGiles(str []rune) (bool, Pinyin, []rune) {
+	for len(str) > 0 && (str[0] == ' ' || str[0] == '-') {
+		str = str[1:]
+	}
+	ok, spelling, _, rest := longestMatch(str, maxWadeGilesLength,
+		wadeGilesLetter, func(sp string) bool {
+			ok, _ := wadeGilesSound(sp)
+			return ok
+		})
+	if !ok {
+		return false, 0, nil
+	}
+	_, sound := wadeGilesSound(spelling)
+	tone := Neutral
+	if len(rest) > 0 {
+		for i, sup := range superscriptTones {
+			if rest[0] == sup || rest[0] == rune('1'+i) {
+				tone = Tone(i + 1)
+				rest = rest[1:]
+				break
+			}
+		}
+	}
+	return true, New(sound, tone), rest
+}
+
+// ParseManyWadeGiles parses many runes written in Wade-Giles until all
+// runes are consumed, or a parse error is encountered. Syllables may
+// be separated by spaces or hyphens.
+func ParseManyWadeGiles(str []rune) ([]Pinyin, []rune) {
+	var result []Pinyin
+	for {
+		if len(str) == 0 {
+			return result, nil
+		}
+		ok, p, rest := ParseWadeGiles(str)
+		if !ok {
+			return result, str
+		}
+		result = append(result, p)
+		str = rest
+	}
+}
+
+// RenderManyWadeGiles renders a slice of pinyins using Wade-Giles,
+// joining the syllables with hyphens.
+func RenderManyWadeGiles(ps []Pinyin) string {
+	var buf strings.Builder
+	RenderManyWadeGilesWriter(&buf, ps)
+	return buf.String()
+}
+
+// RenderManyWadeGilesWriter renders a slice of pinyins using
+// Wade-Giles to a writer.
+func RenderManyWadeGilesWriter(w io.Writer, ps []Pinyin) (int, error) {
+	c := 0
+	for i, p := range ps {
+		if i != 0 {
+			n, err := fmt.Fprint(w, "-")
+			c += n
+			if err != nil {
+				return c, err
+			}
+		}
+		n, err := fmt.Fprint(w, p.WadeGiles())
+		c += n
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
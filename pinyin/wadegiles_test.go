@@ -0,0 +1,89 @@
+package pinyin
+
+import "testing"
+
+func TestWadeGiles(t *testing.T) {
+	tests := []struct {
+		Pinyin    string
+		WadeGiles string
+	}{
+		{"qing1", "ch'ing¹"},
+		{"zhong1", "chung¹"},
+		{"guo2", "kuo²"},
+		{"xue2", "hsüeh²"},
+		{"ri4", "jih⁴"},
+		{"zi5", "tzŭ"},
+		{"si4", "ssŭ⁴"},
+		{"duo1", "to¹"},
+		{"gui4", "kuei⁴"},
+		{"ge1", "ko¹"},
+		{"de2", "tê²"},
+		{"lian2", "lien²"},
+		{"er4", "êrh⁴"},
+	}
+	for _, test := range tests {
+		t.Run(test.Pinyin, func(t *testing.T) {
+			ok, p, rest := Parse([]rune(test.Pinyin))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse pinyin")
+			}
+			if wg := p.WadeGiles(); wg != test.WadeGiles {
+				t.Errorf("wrong wade-giles: %q", wg)
+			}
+			ok, parsed, rest := ParseWadeGiles([]rune(test.WadeGiles))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse wade-giles")
+			}
+			if parsed != p {
+				t.Errorf("wrong result: %s", parsed)
+			}
+		})
+	}
+}
+
+func TestParseManyWadeGiles(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output string
+	}{
+		{"Ch'ing", "qing"},
+		{"Mao Tse-tung", "mao ze dong"},
+		{"Chiang Chieh-shih", "jiang jie shi"},
+		{"ch’ing1-tao3", "qīng dǎo"},
+		{"Tzu-hsi", "zi xi"},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			result, _ := ParseManyWadeGiles([]rune(test.Input))
+			var actual string
+			for i, p := range result {
+				if i != 0 {
+					actual += " "
+				}
+				actual += p.String()
+			}
+			if actual != test.Output {
+				t.Errorf("wrong result: %q", actual)
+			}
+		})
+	}
+	ps, _ := ParseManyWadeGiles([]rune("pei3-ching1"))
+	if s := RenderManyWadeGiles(ps); s != "pei³-ching¹" {
+		t.Errorf("wrong rendering: %q", s)
+	}
+}
+
+func TestWadeGilesRoundTrip(t *testing.T) {
+	for s := Sound(0); s < numSounds; s++ {
+		for tone := Neutral; tone <= Falling; tone++ {
+			p := New(s, tone)
+			ok, parsed, rest := ParseWadeGiles([]rune(p.WadeGiles()))
+			if !ok || len(rest) != 0 {
+				t.Fatalf("failed to parse %q", p.WadeGiles())
+			}
+			if parsed.WadeGiles() != p.WadeGiles() {
+				t.Errorf("%s parsed as %s", p, parsed)
+			}
+		}
+	}
+}
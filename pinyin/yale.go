@@ -0,0 +1,103 @@
+package pinyin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Yale renders a pinyin using the Yale romanization, with the tone
+// marked by a diacritic. The neutral tone is left unmarked.
+func (p Pinyin) Yale() string {
+	c, t := p.Decode()
+	runes := []rune(c.yale())
+	if t == Neutral {
+		return string(runes)
+	}
+	pos := yaleTonePosition(runes)
+	return string(runes[:pos]) + markLetter(runes[pos], t) +
+		string(runes[pos+1:])
+}
+
+// yaleTonePosition returns the position of the letter carrying the
+// tone mark. Syllables without vowel, like "shr" or "dz", mark their
+// last r, z, m or n.
+func yaleTonePosition(rs []rune) int {
+	if strings.ContainsAny(string(rs), "aeiou") {
+		return tonePosition(rs)
+	}
+	for i := len(rs) - 1; i >= 0; i-- {
+		switch rs[i] {
+		case 'r', 'z', 'm', 'n':
+			return i
+		}
+	}
+	return 0
+}
+
+// ParseYale parses a single pinyin written in Yale from a slice of
+// runes. Tones may be marked with precomposed letters or combining
+// diacritics, syllables without tone mark have the neutral tone.
+// Returns false if no pinyin could be parsed, otherwise true, the
+// parsed pinyin and the remaining slice of runes.
+func ParseYale(str []rune) (bool, Pinyin, []rune) {
+	for len(str) > 0 && str[0] == ' ' {
+		str = str[1:]
+	}
+	ok, spelling, tone, rest := longestMatch(str, maxYaleLength,
+		unmarkLetter, func(sp string) bool {
+			ok, _ := yaleSound(sp)
+			return ok
+		})
+	if !ok {
+		return false, 0, nil
+	}
+	_, sound := yaleSound(spelling)
+	return true, New(sound, tone), rest
+}
+
+// ParseManyYale parses many runes written in Yale until all runes are
+// consumed, or a parse error is encountered.
+func ParseManyYale(str []rune) ([]Pinyin, []rune) {
+	var result []Pinyin
+	for {
+		if len(str) == 0 {
+			return result, nil
+		}
+		ok, p, rest := ParseYale(str)
+		if !ok {
+			return result, str
+		}
+		result = append(result, p)
+		str = rest
+	}
+}
+
+// RenderManyYale renders a slice of pinyins using Yale, separating the
+// syllables by spaces.
+func RenderManyYale(ps []Pinyin) string {
+	var buf strings.Builder
+	RenderManyYaleWriter(&buf, ps)
+	return buf.String()
+}
+
+// RenderManyYaleWriter renders a slice of pinyins using Yale to a
+// writer.
+func RenderManyYaleWriter(w io.Writer, ps []Pinyin) (int, error) {
+	c := 0
+	for i, p := range ps {
+		if i != 0 {
+			n, err := fmt.Fprint(w, " ")
+			c += n
+			if err != nil {
+				return c, err
+			}
+		}
+		n, err := fmt.Fprint(w, p.Yale())
+		c += n
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,71 @@
+package pinyin
+
+import "testing"
+
+func TestYale(t *testing.T) {
+	tests := []struct {
+		Pinyin string
+		Yale   string
+	}{
+		{"zhong1", "jūng"},
+		{"guo2", "gwó"},
+		{"xue2", "sywé"},
+		{"shi2", "shŕ"},
+		{"shi4", "shr̀"},
+		{"zi4", "dz̀"},
+		{"ci2", "tsź"},
+		{"jiu3", "jyǒu"},
+		{"dui4", "dwèi"},
+		{"nü3", "nyǔ"},
+		{"xi1", "syī"},
+		{"ma5", "ma"},
+	}
+	for _, test := range tests {
+		t.Run(test.Pinyin, func(t *testing.T) {
+			ok, p, rest := Parse([]rune(test.Pinyin))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse pinyin")
+			}
+			if y := p.Yale(); y != test.Yale {
+				t.Errorf("wrong yale: %q", y)
+			}
+			ok, parsed, rest := ParseYale([]rune(test.Yale))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse yale")
+			}
+			if parsed != p {
+				t.Errorf("wrong result: %s", parsed)
+			}
+		})
+	}
+}
+
+func TestParseManyYale(t *testing.T) {
+	result, rest := ParseManyYale([]rune("Jūng gwó hwà"))
+	if len(rest) != 0 || RenderMany(result) != "zhōngguóhuà" {
+		t.Errorf("wrong result: %s", RenderMany(result))
+	}
+	// combining marks instead of precomposed letters
+	result, rest = ParseManyYale([]rune("mǎ"))
+	if len(rest) != 0 || RenderMany(result) != "mǎ" {
+		t.Errorf("wrong result: %s", RenderMany(result))
+	}
+	if y := RenderManyYale(result); y != "mǎ" {
+		t.Errorf("wrong rendering: %q", y)
+	}
+}
+
+func TestYaleRoundTrip(t *testing.T) {
+	for s := Sound(0); s < numSounds; s++ {
+		for tone := Neutral; tone <= Falling; tone++ {
+			p := New(s, tone)
+			ok, parsed, rest := ParseYale([]rune(p.Yale()))
+			if !ok || len(rest) != 0 {
+				t.Fatalf("failed to parse %q", p.Yale())
+			}
+			if parsed.Yale() != p.Yale() {
+				t.Errorf("%s parsed as %s", p, parsed)
+			}
+		}
+	}
+}
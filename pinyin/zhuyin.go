@@ -0,0 +1,142 @@
+package pinyin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Tone marks of zhuyin. The flat tone is usually left unmarked, the
+// neutral tone is marked in front of the syllable.
+const (
+	zhuyinFlat    = 'ˉ'
+	zhuyinRising  = 'ˊ'
+	zhuyinLow     = 'ˇ'
+	zhuyinFalling = 'ˋ'
+	zhuyinNeutral = '˙'
+)
+
+// Zhuyin renders a pinyin using zhuyin (bopomofo) symbols.
+func (p Pinyin) Zhuyin() string {
+	c, t := p.Decode()
+	str := c.zhuyin()
+	switch t {
+	case Rising:
+		return str + string(zhuyinRising)
+	case Low:
+		return str + string(zhuyinLow)
+	case Falling:
+		return str + string(zhuyinFalling)
+	case Neutral:
+		return string(zhuyinNeutral) + str
+	}
+	return str
+}
+
+// ParseZhuyin parses a single pinyin written in zhuyin from a slice of
+// runes. A syllable without tone mark has the flat tone. Returns false
+// if no pinyin could be parsed, otherwise true, the parsed pinyin and
+// the remaining slice of runes.
+func ParseZhuyin(str []rune) (bool, Pinyin, []rune) {
+	for len(str) > 0 && str[0] == ' ' {
+		str = str[1:]
+	}
+	neutral := len(str) > 0 && str[0] == zhuyinNeutral
+	if neutral {
+		str = str[1:]
+	}
+	var sound Sound
+	found := false
+	for l := maxZhuyinLength; l > 0 && !found; l-- {
+		if l > len(str) {
+			continue
+		}
+		found, sound = zhuyinSound(string(str[:l]))
+		if found {
+			str = str[l:]
+		}
+	}
+	if !found {
+		return false, 0, nil
+	}
+	tone := Flat
+	if neutral {
+		tone = Neutral
+	} else if len(str) > 0 {
+		// the neutral tone mark is sometimes written after the
+		// syllable as well, unless it belongs to the next one
+		t, ok := zhuyinTone(str[0])
+		if ok && (t != Neutral || len(str) == 1 || !isZhuyin(str[1])) {
+			tone = t
+			str = str[1:]
+		}
+	}
+	return true, New(sound, tone), str
+}
+
+// isZhuyin tells whether a rune is a symbol of the bopomofo blocks.
+func isZhuyin(r rune) bool {
+	return (r >= 0x3105 && r <= 0x312f) || (r >= 0x31a0 && r <= 0x31bf)
+}
+
+func zhuyinTone(r rune) (Tone, bool) {
+	switch r {
+	case zhuyinFlat:
+		return Flat, true
+	case zhuyinRising:
+		return Rising, true
+	case zhuyinLow:
+		return Low, true
+	case zhuyinFalling:
+		return Falling, true
+	case zhuyinNeutral:
+		return Neutral, true
+	}
+	return 0, false
+}
+
+// ParseManyZhuyin parses many runes written in zhuyin until all runes
+// are consumed, or a parse error is encountered.
+func ParseManyZhuyin(str []rune) ([]Pinyin, []rune) {
+	var result []Pinyin
+	for {
+		if len(str) == 0 {
+			return result, nil
+		}
+		ok, p, rest := ParseZhuyin(str)
+		if !ok {
+			return result, str
+		}
+		result = append(result, p)
+		str = rest
+	}
+}
+
+// RenderManyZhuyin renders a slice of pinyins using zhuyin, separating
+// the syllables by spaces.
+func RenderManyZhuyin(ps []Pinyin) string {
+	var buf strings.Builder
+	RenderManyZhuyinWriter(&buf, ps)
+	return buf.String()
+}
+
+// RenderManyZhuyinWriter renders a slice of pinyins using zhuyin to a
+// writer.
+func RenderManyZhuyinWriter(w io.Writer, ps []Pinyin) (int, error) {
+	c := 0
+	for i, p := range ps {
+		if i != 0 {
+			n, err := fmt.Fprint(w, " ")
+			c += n
+			if err != nil {
+				return c, err
+			}
+		}
+		n, err := fmt.Fprint(w, p.Zhuyin())
+		c += n
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
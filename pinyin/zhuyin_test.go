@@ -0,0 +1,136 @@
+package pinyin
+
+import "testing"
+
+func TestZhuyin(t *testing.T) {
+	tests := []struct {
+		Pinyin string
+		Zhuyin string
+	}{
+		{
+			Pinyin: "zhong1",
+			Zhuyin: "ㄓㄨㄥ",
+		},
+		{
+			Pinyin: "guo2",
+			Zhuyin: "ㄍㄨㄛˊ",
+		},
+		{
+			Pinyin: "ni3",
+			Zhuyin: "ㄋㄧˇ",
+		},
+		{
+			Pinyin: "shi4",
+			Zhuyin: "ㄕˋ",
+		},
+		{
+			Pinyin: "ma5",
+			Zhuyin: "˙ㄇㄚ",
+		},
+		{
+			Pinyin: "lu:e4",
+			Zhuyin: "ㄌㄩㄝˋ",
+		},
+		{
+			Pinyin: "xiong2",
+			Zhuyin: "ㄒㄩㄥˊ",
+		},
+		{
+			Pinyin: "yuan2",
+			Zhuyin: "ㄩㄢˊ",
+		},
+		{
+			Pinyin: "er4",
+			Zhuyin: "ㄦˋ",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Pinyin, func(t *testing.T) {
+			ok, p, rest := Parse([]rune(test.Pinyin))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse pinyin")
+			}
+			if z := p.Zhuyin(); z != test.Zhuyin {
+				t.Errorf("wrong zhuyin: %q", z)
+			}
+			ok, parsed, rest := ParseZhuyin([]rune(test.Zhuyin))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse zhuyin")
+			}
+			if parsed != p {
+				t.Errorf("wrong result: %s", parsed)
+			}
+		})
+	}
+}
+
+func TestParseZhuyin(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output string
+	}{
+		{
+			Input:  "ㄇㄚˉ",
+			Output: "mā",
+		},
+		{
+			Input:  "ㄇㄚ˙",
+			Output: "ma",
+		},
+		{
+			Input:  "ㄨㄥ",
+			Output: "wēng",
+		},
+		{
+			Input:  "ㄦ",
+			Output: "ēr",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			ok, result, rest := ParseZhuyin([]rune(test.Input))
+			if !ok || len(rest) != 0 {
+				t.Fatal("failed to parse")
+			}
+			if result.String() != test.Output {
+				t.Errorf("wrong result: %s", result)
+			}
+		})
+	}
+}
+
+func TestParseManyZhuyin(t *testing.T) {
+	result, rest := ParseManyZhuyin([]rune("ㄓㄨㄥ ㄍㄨㄛˊ˙ㄉㄜxyz"))
+	if RenderMany(result) != "zhōngguóde" {
+		t.Errorf("wrong result: %s", RenderMany(result))
+	}
+	if string(rest) != "xyz" {
+		t.Errorf("wrong rest: %q", string(rest))
+	}
+	if z := RenderManyZhuyin(result); z != "ㄓㄨㄥ ㄍㄨㄛˊ ˙ㄉㄜ" {
+		t.Errorf("wrong zhuyin: %q", z)
+	}
+}
+
+func TestParseManyZhuyinNeutral(t *testing.T) {
+	result, rest := ParseManyZhuyin([]rune("ㄓㄨㄥ˙ㄉㄜ"))
+	if len(rest) != 0 || RenderMany(result) != "zhōngde" {
+		t.Errorf("wrong result: %s", RenderMany(result))
+	}
+}
+
+func TestZhuyinRoundTrip(t *testing.T) {
+	for s := A; s.String() != "?"; s++ {
+		for tone := Neutral; tone <= Falling; tone++ {
+			p := New(s, tone)
+			ok, parsed, rest := ParseZhuyin([]rune(p.Zhuyin()))
+			if !ok || len(rest) != 0 {
+				t.Fatalf("failed to parse %q", p.Zhuyin())
+			}
+			if ps, _ := parsed.Decode(); ps.zhuyin() != s.zhuyin() ||
+				parsed.Zhuyin() != p.Zhuyin() {
+				t.Errorf("%s parsed as %s", p, parsed)
+			}
+		}
+	}
+}
@@ -8,8 +8,14 @@ import "github.com/hgoes/hanyu/dict"
 // To converts all traditional characters in a string with simplified
 // ones.
 func To(from string) string {
+	return ToWith(&dict.Main, from)
+}
+
+// ToWith converts all traditional characters in a string with
+// simplified ones, using the given dictionary to find words.
+func ToWith(d dict.Dictionary, from string) string {
 	runes := []rune(from)
-	if ToInplace(runes) {
+	if ToInplaceWith(d, runes) {
 		return string(runes)
 	}
 	return from
@@ -18,9 +24,16 @@ func To(from string) string {
 // ToInplace converts all traditional characters in a slice with
 // simplified ones, updating the slice in-place.
 func ToInplace(from []rune) bool {
+	return ToInplaceWith(&dict.Main, from)
+}
+
+// ToInplaceWith converts all traditional characters in a slice with
+// simplified ones, updating the slice in-place. The given dictionary
+// is used to find words.
+func ToInplaceWith(d dict.Dictionary, from []rune) bool {
 	replaced := false
 	for len(from) > 0 {
-		l, m := dict.Main.Lookup(from)
+		l, m := d.Lookup(from)
 		if l == 0 {
 			repl, ok := Replacements[from[0]]
 			if ok {